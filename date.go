@@ -1,6 +1,7 @@
 package datetime
 
 import (
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -25,6 +26,16 @@ func NewDate(year, month, day int) Date {
 	return Date{time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)}
 }
 
+// NewDateChecked returns new date from year, month and day like NewDate,
+// but returns an error instead of normalizing overflow, e.g. for February 30.
+// Date doesn't remember its input, so overflow can be detected only here, not after NewDate.
+func NewDateChecked(year, month, day int) (Date, error) {
+	if month < 1 || month > 12 || day < 1 || day > daysInMonth(year, time.Month(month)) {
+		return Date{}, fmt.Errorf("invalid date=%d-%d-%d", year, month, day)
+	}
+	return NewDate(year, month, day), nil
+}
+
 // NewDateFromString returns new date from yyyy-mm-dd string.
 func NewDateFromString(date string) (Date, error) {
 	d, err := time.Parse(dateLayout, date)
@@ -39,6 +50,33 @@ func NewDateFromTime(t time.Time) Date {
 	return Date{time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)}
 }
 
+// DateFromYearDay returns new date from year and day of year, starting from 1.
+func DateFromYearDay(year, yearDay int) (Date, error) {
+	days := 365
+	if daysInMonth(year, time.February) == 29 {
+		days = 366
+	}
+	if yearDay < 1 || yearDay > days {
+		return Date{}, fmt.Errorf("invalid day=%d of year=%d", yearDay, year)
+	}
+	return NewDate(year, 1, yearDay), nil
+}
+
+// DateFromJulianDayNumber returns new date from Julian Day Number, e.g. 2451545 is 2000-01-01.
+func DateFromJulianDayNumber(jdn int) Date {
+	a := jdn + 32044
+	b := (4*a + 3) / 146097
+	c := a - 146097*b/4
+	d := (4*c + 3) / 1461
+	e := c - 1461*d/4
+	m := (5*e + 2) / 153
+
+	day := e - (153*m+2)/5 + 1
+	month := m + 3 - 12*(m/10)
+	year := 100*b + d - 4800 + m/10
+	return NewDate(year, month, day)
+}
+
 // NowDate returns current active day.
 func NowDate(tz *time.Location) Date {
 	now := time.Now().In(tz)
@@ -48,40 +86,185 @@ func NowDate(tz *time.Location) Date {
 // Today returns current active day according to dayStart time.
 func Today(dayStart Time, tz *time.Location) Date {
 	now := time.Now().In(tz)
-	if now.Hour() < dayStart.Hour() || (now.Hour() == dayStart.Hour() && now.Minute() < dayStart.Minute()) {
-		now = now.AddDate(0, 0, -1)
-	}
+	now = now.AddDate(0, 0, -NewFromTime(now).LogicalDayOffset(dayStart))
 	return NewDate(now.Year(), int(now.Month()), now.Day())
 }
 
-// ParseDate tries to parse date (yyyy-mm-dd) using separators: ["-", " ", ".", "-", "_"].
+// DateOrder is an order of year, month and day fields in date string.
+type DateOrder int
+
+const (
+	// YearMonthDay is yyyy-mm-dd order.
+	YearMonthDay DateOrder = iota
+	// DayMonthYear is dd-mm-yyyy order.
+	DayMonthYear
+	// MonthDayYear is mm-dd-yyyy order.
+	MonthDayYear
+)
+
+// ParseDate tries to parse date (yyyy-mm-dd) using separators: ["-", " ", ".", "_", "/"].
+// Year is always expected to be the first field, including slashed dates like yyyy/mm/dd.
+// Use ParseDateWithOrder to parse dates with year in the end, e.g. dd/mm/yyyy.
 func ParseDate(s string) (Date, error) {
+	return ParseDateWithOrder(s, YearMonthDay)
+}
+
+// ParseDateWithOrder tries to parse date using the same separators as ParseDate and provided order of fields.
+// If the first field has 4 digits, it is treated as a year and YearMonthDay order is used regardless of order.
+func ParseDateWithOrder(s string, order DateOrder) (Date, error) {
 	if s == "" {
 		return Date{}, errors.New("date is empty")
 	}
-	seps := []string{"-", " ", ".", "-", "_", "/"}
-	for _, sep := range seps {
-		splitted := strings.Split(s, sep)
-		if len(splitted) == 3 {
-			year, err := strconv.Atoi(splitted[0])
-			if err != nil {
-				return Date{}, fmt.Errorf("parse year=%s: %w", splitted[0], err)
-			}
-
-			month, err := strconv.Atoi(splitted[1])
-			if err != nil {
-				return Date{}, fmt.Errorf("parse month=%s: %w", splitted[1], err)
-			}
-
-			day, err := strconv.Atoi(splitted[2])
-			if err != nil {
-				return Date{}, fmt.Errorf("parse day=%s: %w", splitted[2], err)
-			}
-
-			return NewDate(year, month, day), nil
+	splitted, ok := splitDate(s)
+	if !ok {
+		return Date{}, fmt.Errorf("invalid date=%s", s)
+	}
+
+	yearIdx, monthIdx, dayIdx := 0, 1, 2
+	if len(splitted[0]) != 4 {
+		switch order {
+		case DayMonthYear:
+			yearIdx, monthIdx, dayIdx = 2, 1, 0
+		case MonthDayYear:
+			yearIdx, monthIdx, dayIdx = 2, 0, 1
+		}
+	}
+
+	year, err := strconv.Atoi(splitted[yearIdx])
+	if err != nil {
+		return Date{}, fmt.Errorf("parse year=%s: %w", splitted[yearIdx], err)
+	}
+
+	month, err := strconv.Atoi(splitted[monthIdx])
+	if err != nil {
+		return Date{}, fmt.Errorf("parse month=%s: %w", splitted[monthIdx], err)
+	}
+
+	day, err := strconv.Atoi(splitted[dayIdx])
+	if err != nil {
+		return Date{}, fmt.Errorf("parse day=%s: %w", splitted[dayIdx], err)
+	}
+
+	return NewDate(year, month, day), nil
+}
+
+// splitDate splits date into three non-empty fields using the first found separator for all of them.
+func splitDate(s string) ([]string, bool) {
+	sep := strings.IndexFunc(s, isDateSeparator)
+	if sep < 0 {
+		return nil, false
+	}
+	splitted := strings.Split(s, s[sep:sep+1])
+	if len(splitted) != 3 {
+		return nil, false
+	}
+	for _, field := range splitted {
+		if field == "" {
+			return nil, false
+		}
+	}
+	return splitted, true
+}
+
+func isDateSeparator(r rune) bool {
+	switch r {
+	case '-', ' ', '.', '_', '/':
+		return true
+	}
+	return false
+}
+
+// ParseDatePivot parses date like ParseDate and then normalizes two-digit years using pivot:
+// years below pivot become 20yy and years from pivot to 99 become 19yy.
+// E.g. with pivot 70 "23-04-15" is 2023-04-15 and "85-04-15" is 1985-04-15.
+// Pivot should be in range [0, 100], years equal or greater than 100 are not changed.
+func ParseDatePivot(s string, pivot int) (Date, error) {
+	if pivot < 0 || pivot > 100 {
+		return Date{}, fmt.Errorf("invalid pivot=%d", pivot)
+	}
+	d, err := ParseDate(s)
+	if err != nil {
+		return Date{}, err
+	}
+	year := d.Year()
+	if year >= 100 {
+		return d, nil
+	}
+	if year < pivot {
+		year += 2000
+	} else {
+		year += 1900
+	}
+	return NewDate(year, int(d.Month()), d.Day()), nil
+}
+
+// parseDateAnyLayouts is a list of layouts used by ParseDateAny in order of precedence.
+var parseDateAnyLayouts = []string{
+	time.RFC3339,
+	dateLayout,
+	"01/02/2006",
+	"02/01/2006",
+	"02.01.2006",
+	"Jan 2, 2006",
+	"January 2, 2006",
+	"2 Jan 2006",
+	"2 January 2006",
+	"20060102",
+}
+
+// ParseDateAny tries to parse date using many common layouts and returns the first success.
+// Layouts are tried in the following order: RFC3339 timestamp, yyyy-mm-dd, mm/dd/yyyy, dd/mm/yyyy,
+// dd.mm.yyyy, "Jan 2, 2006", "January 2, 2006", "2 Jan 2006", "2 January 2006", yyyymmdd.
+// So ambiguous input like "01/02/2006" is parsed as month first (January 2).
+// If no layout matches, it falls back to ParseDate.
+func ParseDateAny(s string) (Date, error) {
+	for _, layout := range parseDateAnyLayouts {
+		t, err := time.Parse(layout, s)
+		if err == nil {
+			return NewDateFromTime(t), nil
 		}
 	}
-	return Date{}, fmt.Errorf("invalid date=%s", s)
+	return ParseDate(s)
+}
+
+// ParseISOWeek parses ISO 8601 week in "2006-W02" format and returns ISO year and week.
+// Week should be in range [1, 52] or [1, 53] for years with 53 ISO weeks.
+func ParseISOWeek(s string) (year, week int, err error) {
+	if len(s) != 8 || s[4] != '-' || s[5] != 'W' {
+		return 0, 0, fmt.Errorf("invalid iso week=%s", s)
+	}
+	year, err = strconv.Atoi(s[:4])
+	if err != nil {
+		return 0, 0, fmt.Errorf("parse year=%s: %w", s[:4], err)
+	}
+	week, err = strconv.Atoi(s[6:])
+	if err != nil {
+		return 0, 0, fmt.Errorf("parse week=%s: %w", s[6:], err)
+	}
+	// December 28 is always in the last ISO week of its year.
+	if _, weeks := NewDate(year, 12, 28).ISOWeek(); week < 1 || week > weeks {
+		return 0, 0, fmt.Errorf("invalid week=%d of year=%d", week, year)
+	}
+	return year, week, nil
+}
+
+// WeekdaysOfMonth returns all dates of the month that fall on provided weekday.
+func WeekdaysOfMonth(year, month int, w time.Weekday) []Date {
+	first := NewDate(year, month, 1)
+	day := 1 + (int(w)-int(first.Weekday())+7)%7
+	last := daysInMonth(first.Year(), first.Month())
+
+	out := make([]Date, 0, 5)
+	for ; day <= last; day += 7 {
+		out = append(out, NewDate(first.Year(), int(first.Month()), day))
+	}
+	return out
+}
+
+// NthBusinessDayOfMonth returns n-th business day (Monday to Friday) of the month, starting from 1.
+// Use Weekend.NthBusinessDayOfMonth for other weekend definitions.
+func NthBusinessDayOfMonth(year, month, n int) (Date, error) {
+	return defaultWeekend.NthBusinessDayOfMonth(year, month, n)
 }
 
 // SortDates sorts dates.
@@ -94,11 +277,248 @@ func SortDates(dates []Date, desc bool) {
 	})
 }
 
+// ClosestDate returns candidate with the smallest number of days to target, ties are resolved to the earlier date.
+// It returns false if there are no candidates.
+func ClosestDate(target Date, candidates []Date) (Date, bool) {
+	if len(candidates) == 0 {
+		return Date{}, false
+	}
+	best := candidates[0]
+	for _, c := range candidates[1:] {
+		r, bestRange := c.Range(target), best.Range(target)
+		if r < bestRange || (r == bestRange && c.Before(best.Time)) {
+			best = c
+		}
+	}
+	return best, true
+}
+
+// MissingDates returns sorted dates between the earliest and the latest of provided dates that are not in the list.
+// Dates may be unsorted and contain duplicates. It returns nil if there are no missing dates.
+func MissingDates(dates []Date) []Date {
+	if len(dates) == 0 {
+		return nil
+	}
+	present := make(map[int]struct{}, len(dates))
+	minDay, maxDay := dates[0].JulianDayNumber(), dates[0].JulianDayNumber()
+	for _, d := range dates {
+		day := d.JulianDayNumber()
+		present[day] = struct{}{}
+		if day < minDay {
+			minDay = day
+		}
+		if day > maxDay {
+			maxDay = day
+		}
+	}
+
+	var out []Date
+	for day := minDay + 1; day < maxDay; day++ {
+		if _, ok := present[day]; !ok {
+			out = append(out, DateFromJulianDayNumber(day))
+		}
+	}
+	return out
+}
+
 // String returns date in yyyy-mm-dd format.
 func (d Date) String() string {
 	return d.Format(dateLayout)
 }
 
+// StringWith returns date in yyyy<sep>mm<sep>dd format, month and day are zero-padded to two digits.
+func (d Date) StringWith(sep string) string {
+	return fmt.Sprintf("%04d%s%02d%s%02d", d.Year(), sep, d.Month(), sep, d.Day())
+}
+
+// StringCompact returns date in yyyymmdd format.
+func (d Date) StringCompact() string {
+	return d.StringWith("")
+}
+
+// StringSlashed returns date in yyyy/mm/dd format.
+func (d Date) StringSlashed() string {
+	return d.StringWith("/")
+}
+
+// AppendFormat appends date in yyyy-mm-dd format to b and returns the extended buffer.
+// It doesn't allocate if b has enough capacity.
+func (d Date) AppendFormat(b []byte) []byte {
+	return d.Time.AppendFormat(b, dateLayout)
+}
+
+// MonthName returns full name of the month in provided language. English is used for unknown languages.
+func (d Date) MonthName(lang string) string {
+	return getLocale(lang).months[d.Month()-1]
+}
+
+// MonthNameShort returns short name of the month in provided language. English is used for unknown languages.
+func (d Date) MonthNameShort(lang string) string {
+	return getLocale(lang).monthsShort[d.Month()-1]
+}
+
+// StringWithWeekday returns date in "Sat, yyyy-mm-dd" format with short weekday name in provided language.
+// English is used for unknown languages.
+func (d Date) StringWithWeekday(lang string) string {
+	return getLocale(lang).weekdaysShort[d.Weekday()] + ", " + d.String()
+}
+
+// FormatOrdinal returns date in English "April 15th, 2023" format.
+func (d Date) FormatOrdinal() string {
+	return fmt.Sprintf("%s %d%s, %d", d.Month(), d.Day(), ordinalSuffix(d.Day()), d.Year())
+}
+
+// FormatDateRange returns human-readable range of dates with short month names in provided language,
+// collapsing shared parts: "Apr 15–17, 2023" for the same month, "Apr 30 – May 2, 2023" for the same year
+// and "Dec 31, 2022 – Jan 2, 2023" otherwise. Dates are swapped if end is before start.
+// English is used for unknown languages.
+func FormatDateRange(start, end Date, lang string) string {
+	if end.Before(start.Time) {
+		start, end = end, start
+	}
+	l := getLocale(lang)
+	startMonth, endMonth := l.monthsShort[start.Month()-1], l.monthsShort[end.Month()-1]
+
+	switch {
+	case start.EqualDate(end):
+		return fmt.Sprintf("%s %d, %d", startMonth, start.Day(), start.Year())
+	case start.Year() == end.Year() && start.Month() == end.Month():
+		return fmt.Sprintf("%s %d–%d, %d", startMonth, start.Day(), end.Day(), end.Year())
+	case start.Year() == end.Year():
+		return fmt.Sprintf("%s %d – %s %d, %d", startMonth, start.Day(), endMonth, end.Day(), end.Year())
+	default:
+		return fmt.Sprintf("%s %d, %d – %s %d, %d", startMonth, start.Day(), start.Year(), endMonth, end.Day(), end.Year())
+	}
+}
+
+// YearDay returns day of the year, in range [1, 365] for non-leap years and [1, 366] for leap years.
+func (d Date) YearDay() int {
+	return d.Time.YearDay()
+}
+
+// FormatISOWeek returns ISO 8601 week of the date in "2006-W02" format, e.g. "2023-W15".
+// Note that ISO year may differ from calendar year near the year boundary, e.g. 2021-01-01 is "2020-W53".
+func (d Date) FormatISOWeek() string {
+	year, week := d.ISOWeek()
+	return fmt.Sprintf("%04d-W%02d", year, week)
+}
+
+// ISOWeekday returns ISO 8601 number of weekday, from 1 for Monday to 7 for Sunday.
+func (d Date) ISOWeekday() int {
+	if d.Weekday() == time.Sunday {
+		return 7
+	}
+	return int(d.Weekday())
+}
+
+// JulianDayNumber returns Julian Day Number of the date in the proleptic Gregorian calendar,
+// e.g. 2451545 for 2000-01-01.
+func (d Date) JulianDayNumber() int {
+	a := (14 - int(d.Month())) / 12
+	y := d.Year() + 4800 - a
+	m := int(d.Month()) + 12*a - 3
+	return d.Day() + (153*m+2)/5 + 365*y + y/4 - y/100 + y/400 - 32045
+}
+
+// WeekOfMonth returns number of week in month starting from 1, weeks start on firstDay.
+// The first partial week of month is week 1, e.g. with Monday as the first day April 2, 2023 (Sunday)
+// is in week 1 and April 3 is in week 2.
+func (d Date) WeekOfMonth(firstDay time.Weekday) int {
+	first := NewDate(d.Year(), int(d.Month()), 1)
+	offset := (int(first.Weekday()) - int(firstDay) + 7) % 7
+	return (d.Day()-1+offset)/7 + 1
+}
+
+// Monday returns Monday of the ISO week containing the date.
+func (d Date) Monday() Date {
+	return NewDate(d.Year(), int(d.Month()), d.Day()-d.ISOWeekday()+1)
+}
+
+// Sunday returns Sunday of the ISO week containing the date.
+func (d Date) Sunday() Date {
+	return NewDate(d.Year(), int(d.Month()), d.Day()-d.ISOWeekday()+7)
+}
+
+// IsStartOfMonth returns true if date is the first day of month.
+func (d Date) IsStartOfMonth() bool {
+	return d.Day() == 1
+}
+
+// IsEndOfMonth returns true if date is the last day of month.
+func (d Date) IsEndOfMonth() bool {
+	return d.Day() == daysInMonth(d.Year(), d.Month())
+}
+
+// DaysRemainingInMonth returns number of days left in the month after the date, the date itself is not counted.
+// It returns 0 for the last day of month.
+func (d Date) DaysRemainingInMonth() int {
+	return daysInMonth(d.Year(), d.Month()) - d.Day()
+}
+
+// DaysRemainingInYear returns number of days left in the year after the date, the date itself is not counted.
+// It returns 0 for December 31.
+func (d Date) DaysRemainingInYear() int {
+	return NewDate(d.Year(), 12, 31).YearDay() - d.YearDay()
+}
+
+// FirstDayOfNextMonth returns the first day of the month following the date.
+func (d Date) FirstDayOfNextMonth() Date {
+	return NewDate(d.Year(), int(d.Month())+1, 1)
+}
+
+// LastDayOfPrevMonth returns the last day of the month preceding the date.
+func (d Date) LastDayOfPrevMonth() Date {
+	return NewDate(d.Year(), int(d.Month()), 0)
+}
+
+// IsWeekend returns true if date is Saturday or Sunday.
+func (d Date) IsWeekend() bool {
+	return defaultWeekend.IsWeekend(d)
+}
+
+// IsBusinessDay returns true if date is not Saturday or Sunday.
+func (d Date) IsBusinessDay() bool {
+	return defaultWeekend.IsBusinessDay(d)
+}
+
+// BusinessDayOfMonth returns number of business day (Monday to Friday) in the month, starting from 1.
+// It returns 0 if date is a weekend. Use Weekend.BusinessDayOfMonth for other weekend definitions.
+func (d Date) BusinessDayOfMonth() int {
+	return defaultWeekend.BusinessDayOfMonth(d)
+}
+
+// NextWorkingDay returns the first date strictly after the receiver that is not Saturday, Sunday or one of holidays.
+// Use Weekend.NextWorkingDay for other weekend definitions.
+func (d Date) NextWorkingDay(holidays []Date) Date {
+	return defaultWeekend.NextWorkingDay(d, holidays)
+}
+
+// NextMonthDay returns the nearest date on or after the receiver with provided day of month.
+// Months without such day (e.g. 31st in April) are skipped. It returns zero Date if day is not in range [1, 31].
+func (d Date) NextMonthDay(day int) Date {
+	if day < 1 || day > 31 {
+		return Date{}
+	}
+	year, month := d.Year(), d.Month()
+	if d.Day() > day {
+		month++
+	}
+	for {
+		first := NewDate(year, int(month), 1)
+		if day <= daysInMonth(first.Year(), first.Month()) {
+			return NewDate(first.Year(), int(first.Month()), day)
+		}
+		month++
+	}
+}
+
+// AddDuration returns date moved by the number of whole days in dur. Remainder of a day is truncated toward zero,
+// e.g. 36h moves date one day forward, 23h doesn't move it and -36h moves it one day back.
+func (d Date) AddDuration(dur time.Duration) Date {
+	days := int(dur / (24 * time.Hour))
+	return NewDate(d.Year(), int(d.Month()), d.Day()+days)
+}
+
 // Round returns new Date instance with Round(0).
 func (d Date) Round() Date {
 	return Date{d.Time.Round(0)}
@@ -137,11 +557,100 @@ func (d Date) Range(other Date) int {
 	return r / 86400
 }
 
+// RotationIndex returns index of rotation of lengthDays days starting from anchor that the date falls in,
+// e.g. 0 for dates in [anchor, anchor+lengthDays) and -1 for the rotation just before anchor.
+// It returns 0 if lengthDays is not positive.
+func (d Date) RotationIndex(anchor Date, lengthDays int) int {
+	if lengthDays <= 0 {
+		return 0
+	}
+	days := int((d.Unix() - anchor.Unix()) / 86400)
+	index := days / lengthDays
+	if days%lengthDays < 0 {
+		index--
+	}
+	return index
+}
+
+// RangeSafe returns number of days between two dates like Range, but returns an error if any of dates is zero.
+func (d Date) RangeSafe(other Date) (int, error) {
+	if d.IsZero() || other.IsZero() {
+		return 0, errors.New("date is empty")
+	}
+	return d.Range(other), nil
+}
+
+// DiffComponents returns calendar difference between dates in years, months and days.
+// Result doesn't depend on the order of dates. Months are added with clamping to the end of month,
+// so the difference between 2023-01-31 and 2023-03-01 is 1 month and 1 day.
+func (d Date) DiffComponents(other Date) (years, months, days int) {
+	start, end := d, other
+	if end.Before(start.Time) {
+		start, end = end, start
+	}
+
+	total := (end.Year()-start.Year())*12 + int(end.Month()) - int(start.Month())
+	shifted := addMonthsClamped(start, total)
+	if shifted.After(end.Time) {
+		total--
+		shifted = addMonthsClamped(start, total)
+	}
+
+	return total / 12, total % 12, shifted.Range(end)
+}
+
+// Age returns number of full years passed from the date to asOf, e.g. age of a person born on the date.
+// People born on February 29 become one year older on March 1 in non-leap years.
+// Result is negative if asOf is before the date.
+func (d Date) Age(asOf Date) int {
+	age := asOf.Year() - d.Year()
+	if asOf.Month() < d.Month() || (asOf.Month() == d.Month() && asOf.Day() < d.Day()) {
+		age--
+	}
+	return age
+}
+
 // IsToday returns true if provided argument is today.
 func (d Date) IsToday(dayStart Time, tz *time.Location) bool {
 	return d.EqualDate(Today(dayStart, tz))
 }
 
+// StartInstant returns absolute time when the date starts in provided timezone according to dayStart time.
+func (d Date) StartInstant(dayStart Time, tz *time.Location) time.Time {
+	if tz == nil {
+		tz = time.UTC
+	}
+	return time.Date(d.Year(), d.Month(), d.Day(), dayStart.Hour(), dayStart.Minute(), 0, 0, tz)
+}
+
+// EndInstant returns absolute time when the date ends in provided timezone according to dayStart time.
+// It is the last nanosecond before the start of the next day, so the bounds are inclusive.
+func (d Date) EndInstant(dayStart Time, tz *time.Location) time.Time {
+	return d.NextDay().StartInstant(dayStart, tz).Add(-time.Nanosecond)
+}
+
+// Since returns duration passed from the start of the date in provided timezone according to dayStart time.
+// It is positive if the date has started in the past and negative if it is in the future.
+func (d Date) Since(dayStart Time, tz *time.Location) time.Duration {
+	return d.SinceAt(time.Now(), dayStart, tz)
+}
+
+// SinceAt is like Since, but measures duration at the provided instant instead of the current time.
+func (d Date) SinceAt(now time.Time, dayStart Time, tz *time.Location) time.Duration {
+	return now.Sub(d.StartInstant(dayStart, tz))
+}
+
+// Until returns duration until the start of the date in provided timezone according to dayStart time.
+// It is positive if the date is in the future and negative if it has started in the past.
+func (d Date) Until(dayStart Time, tz *time.Location) time.Duration {
+	return d.UntilAt(time.Now(), dayStart, tz)
+}
+
+// UntilAt is like Until, but measures duration at the provided instant instead of the current time.
+func (d Date) UntilAt(now time.Time, dayStart Time, tz *time.Location) time.Duration {
+	return d.StartInstant(dayStart, tz).Sub(now)
+}
+
 // IsArgNextDay returns true if provided argument is after Date.
 func (d Date) IsArgNextDay(t Date) bool {
 	if d.Year() < t.Year() {
@@ -166,11 +675,16 @@ func (d Date) IsArgNextDay(t Date) bool {
 }
 
 // MarshalJSON implements json.Marshaler interface to marshal Date to JSON.
+// Zero date is marshaled to null.
 func (d Date) MarshalJSON() ([]byte, error) {
+	if d.IsZero() {
+		return []byte("null"), nil
+	}
 	return []byte(`"` + d.String() + `"`), nil
 }
 
 // UnmarshalJSON implements json.Unmarshaler interface to unmarshal Date from JSON.
+// It accepts yyyy-mm-dd string or RFC3339 timestamp, in the latter case only date is used.
 func (d *Date) UnmarshalJSON(data []byte) error {
 	var s string
 	if err := json.Unmarshal(data, &s); err != nil {
@@ -181,12 +695,67 @@ func (d *Date) UnmarshalJSON(data []byte) error {
 	}
 	res, err := NewDateFromString(s)
 	if err != nil {
-		return err
+		t, rfcErr := time.Parse(time.RFC3339, s)
+		if rfcErr != nil {
+			return err
+		}
+		res = NewDateFromTime(t)
 	}
 	d.Time = res.Time
 	return nil
 }
 
+// MarshalBinary implements encoding.BinaryMarshaler interface to marshal Date to 4 bytes
+// with packed year, month and day. Zero date is marshaled to zero bytes.
+func (d Date) MarshalBinary() ([]byte, error) {
+	out := make([]byte, 4)
+	if d.IsZero() {
+		return out, nil
+	}
+	if d.Year() < 0 || d.Year() >= 1<<23 {
+		return nil, fmt.Errorf("year=%d is out of range", d.Year())
+	}
+	binary.BigEndian.PutUint32(out, uint32(d.Year())<<9|uint32(d.Month())<<5|uint32(d.Day()))
+	return out, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler interface to unmarshal Date from 4 bytes.
+func (d *Date) UnmarshalBinary(data []byte) error {
+	if len(data) != 4 {
+		return fmt.Errorf("invalid data length=%d", len(data))
+	}
+	packed := binary.BigEndian.Uint32(data)
+	if packed == 0 {
+		*d = Date{}
+		return nil
+	}
+	year, month, day := int(packed>>9), int(packed>>5&0xF), int(packed&0x1F)
+	if month < 1 || month > 12 || day < 1 || day > daysInMonth(year, time.Month(month)) {
+		return fmt.Errorf("invalid date=%d-%d-%d", year, month, day)
+	}
+	*d = NewDate(year, month, day)
+	return nil
+}
+
+// AgeBucket returns label of the age bucket for person born on birth at asOf date.
+// Bounds are ascending lower ages of buckets, each bucket includes its lower bound,
+// e.g. bounds [18, 25] give buckets "0-17", "18-24" and "25+".
+// It returns "0+" for empty bounds and empty string if birth is after asOf.
+func AgeBucket(birth, asOf Date, bounds []int) string {
+	age := birth.Age(asOf)
+	if age < 0 {
+		return ""
+	}
+	lower := 0
+	for _, b := range bounds {
+		if age < b {
+			return strconv.Itoa(lower) + "-" + strconv.Itoa(b-1)
+		}
+		lower = b
+	}
+	return strconv.Itoa(lower) + "+"
+}
+
 // TransformDatesToString transforms slice of dates to slice of strings.
 func TransformDatesToString(dates []Date) []string {
 	out := make([]string, 0, len(dates))
@@ -195,3 +764,75 @@ func TransformDatesToString(dates []Date) []string {
 	}
 	return out
 }
+
+// DatesFromTimes returns dates of provided time.Time values using NewDateFromTime.
+func DatesFromTimes(ts []time.Time) []Date {
+	out := make([]Date, 0, len(ts))
+	for _, t := range ts {
+		out = append(out, NewDateFromTime(t))
+	}
+	return out
+}
+
+// MarshalDates returns JSON array of dates in yyyy-mm-dd format, zero dates are skipped.
+func MarshalDates(dates []Date) ([]byte, error) {
+	out := make([]string, 0, len(dates))
+	for _, d := range dates {
+		if !d.IsZero() {
+			out = append(out, d.String())
+		}
+	}
+	return json.Marshal(out)
+}
+
+// UnmarshalDates returns dates from JSON array, elements are parsed like in Date.UnmarshalJSON.
+// Null and empty elements are skipped, any malformed element results in an error.
+func UnmarshalDates(data []byte) ([]Date, error) {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	out := make([]Date, 0, len(raw))
+	for i, r := range raw {
+		if string(r) == "null" {
+			continue
+		}
+		var d Date
+		if err := d.UnmarshalJSON(r); err != nil {
+			return nil, fmt.Errorf("date at index %d: %w", i, err)
+		}
+		if !d.IsZero() {
+			out = append(out, d)
+		}
+	}
+	return out, nil
+}
+
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+func ordinalSuffix(n int) string {
+	if n%100 >= 11 && n%100 <= 13 {
+		return "th"
+	}
+	switch n % 10 {
+	case 1:
+		return "st"
+	case 2:
+		return "nd"
+	case 3:
+		return "rd"
+	}
+	return "th"
+}
+
+// addMonthsClamped adds months to date, day is clamped to the last day of the resulting month.
+func addMonthsClamped(d Date, months int) Date {
+	first := NewDate(d.Year(), int(d.Month())+months, 1)
+	day := d.Day()
+	if last := daysInMonth(first.Year(), first.Month()); day > last {
+		day = last
+	}
+	return NewDate(first.Year(), int(first.Month()), day)
+}
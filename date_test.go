@@ -2,6 +2,7 @@ package datetime_test
 
 import (
 	"encoding/json"
+	"strings"
 	"testing"
 	"time"
 
@@ -15,6 +16,33 @@ func TestNewDate(t *testing.T) {
 	}
 }
 
+func TestNewDateChecked(t *testing.T) {
+	cases := []struct {
+		year, month, day int
+		hasError         bool
+	}{
+		{2024, 2, 29, false},
+		{2023, 2, 28, false},
+		{2023, 12, 31, false},
+		{2023, 2, 29, true},
+		{2023, 2, 30, true},
+		{2023, 4, 31, true},
+		{2023, 13, 1, true},
+		{2023, 0, 1, true},
+		{2023, 1, 0, true},
+	}
+
+	for _, c := range cases {
+		d, err := datetime.NewDateChecked(c.year, c.month, c.day)
+		if (err != nil) != c.hasError {
+			t.Errorf("NewDateChecked(%d, %d, %d) = %s, %v; want error=%t", c.year, c.month, c.day, d, err, c.hasError)
+		}
+		if err == nil && !d.EqualDate(datetime.NewDate(c.year, c.month, c.day)) {
+			t.Errorf("NewDateChecked(%d, %d, %d) = %s; want %s", c.year, c.month, c.day, d, datetime.NewDate(c.year, c.month, c.day))
+		}
+	}
+}
+
 func TestNewDateFromString(t *testing.T) {
 	dateStr := "2023-04-15"
 	date, err := datetime.NewDateFromString(dateStr)
@@ -37,6 +65,34 @@ func TestNewDateFromTime(t *testing.T) {
 	}
 }
 
+func TestDateFromYearDay(t *testing.T) {
+	cases := []struct {
+		year, yearDay int
+		expected      string
+		expectErr     bool
+	}{
+		{2023, 1, "2023-01-01", false},
+		{2023, 60, "2023-03-01", false},
+		{2024, 60, "2024-02-29", false},
+		{2023, 105, "2023-04-15", false},
+		{2023, 365, "2023-12-31", false},
+		{2024, 366, "2024-12-31", false},
+		{2023, 366, "", true},
+		{2023, 0, "", true},
+	}
+
+	for _, c := range cases {
+		date, err := datetime.DateFromYearDay(c.year, c.yearDay)
+		if (err != nil) != c.expectErr || (!c.expectErr && date.String() != c.expected) {
+			t.Errorf("DateFromYearDay(%d, %d) = %v, %v; want %v, %v", c.year, c.yearDay, date, err, c.expected, c.expectErr)
+			continue
+		}
+		if !c.expectErr && date.YearDay() != c.yearDay {
+			t.Errorf("YearDay(%s) = %d; want %d", date, date.YearDay(), c.yearDay)
+		}
+	}
+}
+
 func TestNowDate(t *testing.T) {
 	loc, _ := time.LoadLocation("UTC")
 	now := time.Now().In(loc)
@@ -61,7 +117,7 @@ func TestToday(t *testing.T) {
 }
 
 func TestParseDate(t *testing.T) {
-	validDates := []string{"2023-04-15", "2023.04.15", "2023 04 15", "2023_04_15", "2023-04-15"}
+	validDates := []string{"2023-04-15", "2023.04.15", "2023 04 15", "2023_04_15", "2023/04/15", "2023-4-15"}
 	for _, dateStr := range validDates {
 		date, err := datetime.ParseDate(dateStr)
 		if err != nil || !date.EqualDate(datetime.NewDate(2023, 4, 15)) {
@@ -98,6 +154,184 @@ func TestParseDate(t *testing.T) {
 	if err == nil {
 		t.Error("ParseDate should fail for invalid date string")
 	}
+
+	for _, dateStr := range []string{"2023--04-15", "-2023-04-15-", "2023-04 15", "2023-04-15-"} {
+		if _, err = datetime.ParseDate(dateStr); err == nil {
+			t.Errorf("ParseDate should fail for malformed separators: %s", dateStr)
+		}
+	}
+}
+
+func BenchmarkParseDate(b *testing.B) {
+	inputs := []string{"2023-04-15", "2023 04 15", "2023.04.15", "2023_04_15", "2023/04/15"}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := datetime.ParseDate(inputs[i%len(inputs)]); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestDateStringWith(t *testing.T) {
+	cases := []struct {
+		date             datetime.Date
+		compact, slashed string
+		dotted           string
+	}{
+		{datetime.NewDate(2023, 4, 15), "20230415", "2023/04/15", "2023.04.15"},
+		{datetime.NewDate(2023, 1, 5), "20230105", "2023/01/05", "2023.01.05"},
+		{datetime.NewDate(2023, 12, 31), "20231231", "2023/12/31", "2023.12.31"},
+		{datetime.NewDate(999, 3, 7), "09990307", "0999/03/07", "0999.03.07"},
+	}
+
+	for _, c := range cases {
+		if result := c.date.StringCompact(); result != c.compact {
+			t.Errorf("StringCompact(%s) = %s; want %s", c.date, result, c.compact)
+		}
+		if result := c.date.StringSlashed(); result != c.slashed {
+			t.Errorf("StringSlashed(%s) = %s; want %s", c.date, result, c.slashed)
+		}
+		if result := c.date.StringWith("."); result != c.dotted {
+			t.Errorf("StringWith(%s, .) = %s; want %s", c.date, result, c.dotted)
+		}
+		if result := c.date.StringWith("-"); result != c.date.String() {
+			t.Errorf("StringWith(%s, -) = %s; want %s", c.date, result, c.date.String())
+		}
+	}
+}
+
+func TestDateAppendFormat(t *testing.T) {
+	buf := []byte("on ")
+	for _, d := range []datetime.Date{datetime.NewDate(2023, 4, 5), datetime.NewDate(1999, 12, 31), datetime.NewDate(2024, 2, 29)} {
+		if result := string(d.AppendFormat(buf)); result != "on "+d.String() {
+			t.Errorf("AppendFormat() = %s; want %s", result, "on "+d.String())
+		}
+	}
+}
+
+func BenchmarkDateAppendFormat(b *testing.B) {
+	d := datetime.NewDate(2023, 4, 15)
+	buf := make([]byte, 0, 16)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = d.AppendFormat(buf[:0])
+	}
+}
+
+func TestParseDateWithOrder(t *testing.T) {
+	cases := []struct {
+		input     string
+		order     datetime.DateOrder
+		expected  string
+		expectErr bool
+	}{
+		{"2023/04/15", datetime.YearMonthDay, "2023-04-15", false},
+		{"2023/04/15", datetime.DayMonthYear, "2023-04-15", false},
+		{"2023/04/15", datetime.MonthDayYear, "2023-04-15", false},
+		{"15/04/2023", datetime.DayMonthYear, "2023-04-15", false},
+		{"04/15/2023", datetime.MonthDayYear, "2023-04-15", false},
+		{"15.04.2023", datetime.DayMonthYear, "2023-04-15", false},
+		{"15-04-23", datetime.DayMonthYear, "0023-04-15", false},
+		{"15/04/aaaa", datetime.DayMonthYear, "", true},
+		{"", datetime.DayMonthYear, "", true},
+	}
+
+	for _, c := range cases {
+		date, err := datetime.ParseDateWithOrder(c.input, c.order)
+		if (err != nil) != c.expectErr || (!c.expectErr && date.String() != c.expected) {
+			t.Errorf("ParseDateWithOrder(%s, %d) = %v, %v; want %v, %v", c.input, c.order, date, err, c.expected, c.expectErr)
+		}
+	}
+
+	date, err := datetime.ParseDate("2023/04/15")
+	if err != nil || date.String() != "2023-04-15" {
+		t.Errorf("ParseDate(2023/04/15) = %v, %v; want 2023-04-15", date, err)
+	}
+}
+
+func TestParseDatePivot(t *testing.T) {
+	cases := []struct {
+		input     string
+		pivot     int
+		expected  string
+		expectErr bool
+	}{
+		{"23-04-15", 70, "2023-04-15", false},
+		{"85-04-15", 70, "1985-04-15", false},
+		{"69.12.31", 70, "2069-12-31", false},
+		{"70.01.01", 70, "1970-01-01", false},
+		{"00-02-29", 70, "2000-02-29", false},
+		{"2023-04-15", 70, "2023-04-15", false},
+		{"23-04-15", 0, "1923-04-15", false},
+		{"23-04-15", 100, "2023-04-15", false},
+		{"23-04-15", 101, "", true},
+		{"23-04-15", -1, "", true},
+		{"invalid", 70, "", true},
+	}
+
+	for _, c := range cases {
+		date, err := datetime.ParseDatePivot(c.input, c.pivot)
+		if (err != nil) != c.expectErr || (!c.expectErr && date.String() != c.expected) {
+			t.Errorf("ParseDatePivot(%s, %d) = %v, %v; want %v, %v", c.input, c.pivot, date, err, c.expected, c.expectErr)
+		}
+	}
+}
+
+func TestParseDateAny(t *testing.T) {
+	cases := []struct {
+		input     string
+		expected  string
+		expectErr bool
+	}{
+		{"2023-04-15T10:30:00Z", "2023-04-15", false},
+		{"2023-04-15", "2023-04-15", false},
+		{"04/15/2023", "2023-04-15", false},
+		{"15/04/2023", "2023-04-15", false},
+		{"15.04.2023", "2023-04-15", false},
+		{"Apr 15, 2023", "2023-04-15", false},
+		{"April 15, 2023", "2023-04-15", false},
+		{"15 Apr 2023", "2023-04-15", false},
+		{"20230415", "2023-04-15", false},
+		{"2023_04_15", "2023-04-15", false},
+		{"01/02/2006", "2006-01-02", false},
+		{"", "", true},
+		{"invalid", "", true},
+	}
+
+	for _, c := range cases {
+		date, err := datetime.ParseDateAny(c.input)
+		if (err != nil) != c.expectErr || (!c.expectErr && date.String() != c.expected) {
+			t.Errorf("ParseDateAny(%s) = %v, %v; want %v, %v", c.input, date, err, c.expected, c.expectErr)
+		}
+	}
+}
+
+func TestWeekdaysOfMonth(t *testing.T) {
+	cases := []struct {
+		year, month int
+		weekday     time.Weekday
+		expected    []string
+	}{
+		{2023, 4, time.Monday, []string{"2023-04-03", "2023-04-10", "2023-04-17", "2023-04-24"}},
+		{2023, 4, time.Saturday, []string{"2023-04-01", "2023-04-08", "2023-04-15", "2023-04-22", "2023-04-29"}},
+		{2023, 4, time.Sunday, []string{"2023-04-02", "2023-04-09", "2023-04-16", "2023-04-23", "2023-04-30"}},
+		{2023, 2, time.Wednesday, []string{"2023-02-01", "2023-02-08", "2023-02-15", "2023-02-22"}},
+		{2024, 2, time.Thursday, []string{"2024-02-01", "2024-02-08", "2024-02-15", "2024-02-22", "2024-02-29"}},
+	}
+
+	for _, c := range cases {
+		result := datetime.TransformDatesToString(datetime.WeekdaysOfMonth(c.year, c.month, c.weekday))
+		if len(result) != len(c.expected) {
+			t.Errorf("WeekdaysOfMonth(%d, %d, %s) = %v; want %v", c.year, c.month, c.weekday, result, c.expected)
+			continue
+		}
+		for i := range result {
+			if result[i] != c.expected[i] {
+				t.Errorf("WeekdaysOfMonth(%d, %d, %s) = %v; want %v", c.year, c.month, c.weekday, result, c.expected)
+				break
+			}
+		}
+	}
 }
 
 func TestSortDates(t *testing.T) {
@@ -141,6 +375,263 @@ func TestDateMethods(t *testing.T) {
 	}
 }
 
+func TestMonthName(t *testing.T) {
+	cases := []struct {
+		date        datetime.Date
+		lang        string
+		full, short string
+	}{
+		{datetime.NewDate(2023, 4, 15), "en", "April", "Apr"},
+		{datetime.NewDate(2023, 1, 1), "en", "January", "Jan"},
+		{datetime.NewDate(2023, 12, 31), "en", "December", "Dec"},
+		{datetime.NewDate(2023, 4, 15), "ru", "Апрель", "Апр"},
+		{datetime.NewDate(2023, 9, 1), "ru", "Сентябрь", "Сен"},
+		{datetime.NewDate(2023, 4, 15), "xx", "April", "Apr"},
+	}
+
+	for _, c := range cases {
+		if result := c.date.MonthName(c.lang); result != c.full {
+			t.Errorf("MonthName(%s, %s) = %s; want %s", c.date, c.lang, result, c.full)
+		}
+		if result := c.date.MonthNameShort(c.lang); result != c.short {
+			t.Errorf("MonthNameShort(%s, %s) = %s; want %s", c.date, c.lang, result, c.short)
+		}
+	}
+}
+
+func TestStringWithWeekday(t *testing.T) {
+	date := datetime.NewDate(2023, 4, 15)
+	cases := []struct {
+		lang     string
+		expected string
+	}{
+		{"en", "Sat, 2023-04-15"},
+		{"ru", "Сб, 2023-04-15"},
+		{"RU", "Сб, 2023-04-15"},
+		{"xx", "Sat, 2023-04-15"},
+	}
+
+	for _, c := range cases {
+		if result := date.StringWithWeekday(c.lang); result != c.expected {
+			t.Errorf("StringWithWeekday(%s) = %s; want %s", c.lang, result, c.expected)
+		}
+	}
+
+	if result := datetime.NewDate(2023, 4, 17).StringWithWeekday("ru"); result != "Пн, 2023-04-17" {
+		t.Errorf("StringWithWeekday(ru) = %s; want %s", result, "Пн, 2023-04-17")
+	}
+}
+
+func TestFormatDateRange(t *testing.T) {
+	cases := []struct {
+		id         string
+		start, end datetime.Date
+		lang       string
+		expected   string
+	}{
+		{"same_day", datetime.NewDate(2023, 4, 15), datetime.NewDate(2023, 4, 15), "en", "Apr 15, 2023"},
+		{"same_month", datetime.NewDate(2023, 4, 15), datetime.NewDate(2023, 4, 17), "en", "Apr 15–17, 2023"},
+		{"same_year", datetime.NewDate(2023, 4, 30), datetime.NewDate(2023, 5, 2), "en", "Apr 30 – May 2, 2023"},
+		{"full", datetime.NewDate(2022, 12, 31), datetime.NewDate(2023, 1, 2), "en", "Dec 31, 2022 – Jan 2, 2023"},
+		{"reversed", datetime.NewDate(2023, 4, 17), datetime.NewDate(2023, 4, 15), "en", "Apr 15–17, 2023"},
+		{"ru", datetime.NewDate(2023, 4, 30), datetime.NewDate(2023, 5, 2), "ru", "Апр 30 – Май 2, 2023"},
+	}
+
+	for _, c := range cases {
+		if result := datetime.FormatDateRange(c.start, c.end, c.lang); result != c.expected {
+			t.Errorf("%s -> FormatDateRange() = %q; want %q", c.id, result, c.expected)
+		}
+	}
+}
+
+func TestFormatOrdinal(t *testing.T) {
+	cases := []struct {
+		day      int
+		expected string
+	}{
+		{1, "January 1st, 2023"},
+		{2, "January 2nd, 2023"},
+		{3, "January 3rd, 2023"},
+		{4, "January 4th, 2023"},
+		{11, "January 11th, 2023"},
+		{12, "January 12th, 2023"},
+		{13, "January 13th, 2023"},
+		{21, "January 21st, 2023"},
+		{22, "January 22nd, 2023"},
+		{23, "January 23rd, 2023"},
+		{30, "January 30th, 2023"},
+		{31, "January 31st, 2023"},
+	}
+
+	for _, c := range cases {
+		if result := datetime.NewDate(2023, 1, c.day).FormatOrdinal(); result != c.expected {
+			t.Errorf("FormatOrdinal(%d) = %s; want %s", c.day, result, c.expected)
+		}
+	}
+}
+
+func TestFormatISOWeek(t *testing.T) {
+	cases := []struct {
+		date     datetime.Date
+		expected string
+	}{
+		{datetime.NewDate(2023, 4, 15), "2023-W15"},
+		{datetime.NewDate(2023, 1, 9), "2023-W02"},
+		{datetime.NewDate(2021, 1, 1), "2020-W53"},
+		{datetime.NewDate(2024, 12, 30), "2025-W01"},
+		{datetime.NewDate(2023, 1, 1), "2022-W52"},
+	}
+
+	for _, c := range cases {
+		if result := c.date.FormatISOWeek(); result != c.expected {
+			t.Errorf("%s.FormatISOWeek() = %s; want %s", c.date, result, c.expected)
+		}
+	}
+}
+
+func TestParseISOWeek(t *testing.T) {
+	cases := []struct {
+		input      string
+		year, week int
+		hasError   bool
+	}{
+		{"2023-W15", 2023, 15, false},
+		{"2023-W02", 2023, 2, false},
+		{"2020-W53", 2020, 53, false},
+		{"2023-W53", 0, 0, true},
+		{"2023-W00", 0, 0, true},
+		{"2023-W2", 0, 0, true},
+		{"2023W15", 0, 0, true},
+		{"2023-15", 0, 0, true},
+		{"abcd-W15", 0, 0, true},
+		{"2023-Wxx", 0, 0, true},
+	}
+
+	for _, c := range cases {
+		year, week, err := datetime.ParseISOWeek(c.input)
+		if (err != nil) != c.hasError || year != c.year || week != c.week {
+			t.Errorf("ParseISOWeek(%s) = %d, %d, %v; want %d, %d, error=%t", c.input, year, week, err, c.year, c.week, c.hasError)
+		}
+	}
+
+	d := datetime.NewDate(2021, 1, 1)
+	if year, week, err := datetime.ParseISOWeek(d.FormatISOWeek()); err != nil || year != 2020 || week != 53 {
+		t.Errorf("ParseISOWeek(FormatISOWeek()) = %d, %d, %v; want 2020, 53", year, week, err)
+	}
+}
+
+func TestISOWeekday(t *testing.T) {
+	cases := []struct {
+		date     datetime.Date
+		expected int
+	}{
+		{datetime.NewDate(2023, 4, 17), 1},
+		{datetime.NewDate(2023, 4, 19), 3},
+		{datetime.NewDate(2023, 4, 22), 6},
+		{datetime.NewDate(2023, 4, 23), 7},
+	}
+
+	for _, c := range cases {
+		if result := c.date.ISOWeekday(); result != c.expected {
+			t.Errorf("ISOWeekday(%s) = %d; want %d", c.date, result, c.expected)
+		}
+	}
+}
+
+func TestMonthBounds(t *testing.T) {
+	cases := []struct {
+		date       datetime.Date
+		start, end bool
+	}{
+		{datetime.NewDate(2023, 4, 1), true, false},
+		{datetime.NewDate(2023, 4, 15), false, false},
+		{datetime.NewDate(2023, 4, 30), false, true},
+		{datetime.NewDate(2023, 12, 31), false, true},
+		{datetime.NewDate(2023, 2, 28), false, true},
+		{datetime.NewDate(2024, 2, 28), false, false},
+		{datetime.NewDate(2024, 2, 29), false, true},
+	}
+
+	for _, c := range cases {
+		if result := c.date.IsStartOfMonth(); result != c.start {
+			t.Errorf("IsStartOfMonth(%s) = %v; want %v", c.date, result, c.start)
+		}
+		if result := c.date.IsEndOfMonth(); result != c.end {
+			t.Errorf("IsEndOfMonth(%s) = %v; want %v", c.date, result, c.end)
+		}
+	}
+}
+
+func TestBusinessDayOfMonth(t *testing.T) {
+	// April 2023 starts on Saturday.
+	cases := []struct {
+		day      int
+		expected int
+	}{
+		{1, 0},
+		{2, 0},
+		{3, 1},
+		{7, 5},
+		{8, 0},
+		{10, 6},
+		{28, 20},
+	}
+
+	for _, c := range cases {
+		if result := datetime.NewDate(2023, 4, c.day).BusinessDayOfMonth(); result != c.expected {
+			t.Errorf("BusinessDayOfMonth(2023-04-%02d) = %d; want %d", c.day, result, c.expected)
+		}
+	}
+}
+
+func TestNthBusinessDayOfMonth(t *testing.T) {
+	cases := []struct {
+		year, month, n int
+		expected       string
+		expectErr      bool
+	}{
+		{2023, 4, 1, "2023-04-03", false},
+		{2023, 4, 5, "2023-04-07", false},
+		{2023, 4, 6, "2023-04-10", false},
+		{2023, 4, 20, "2023-04-28", false},
+		{2023, 5, 1, "2023-05-01", false},
+		{2023, 4, 21, "", true},
+		{2023, 4, 0, "", true},
+	}
+
+	for _, c := range cases {
+		date, err := datetime.NthBusinessDayOfMonth(c.year, c.month, c.n)
+		if (err != nil) != c.expectErr || (!c.expectErr && date.String() != c.expected) {
+			t.Errorf("NthBusinessDayOfMonth(%d, %d, %d) = %v, %v; want %v, %v", c.year, c.month, c.n, date, err, c.expected, c.expectErr)
+		}
+	}
+}
+
+func TestNextMonthDay(t *testing.T) {
+	cases := []struct {
+		date     datetime.Date
+		day      int
+		expected string
+	}{
+		{datetime.NewDate(2023, 4, 10), 15, "2023-04-15"},
+		{datetime.NewDate(2023, 4, 15), 15, "2023-04-15"},
+		{datetime.NewDate(2023, 4, 16), 15, "2023-05-15"},
+		{datetime.NewDate(2023, 12, 20), 15, "2024-01-15"},
+		{datetime.NewDate(2023, 4, 1), 31, "2023-05-31"},
+		{datetime.NewDate(2023, 1, 31), 30, "2023-03-30"},
+		{datetime.NewDate(2023, 2, 1), 29, "2023-03-29"},
+		{datetime.NewDate(2024, 2, 1), 29, "2024-02-29"},
+		{datetime.NewDate(2023, 2, 1), 0, "0001-01-01"},
+		{datetime.NewDate(2023, 2, 1), 32, "0001-01-01"},
+	}
+
+	for _, c := range cases {
+		if result := c.date.NextMonthDay(c.day); result.String() != c.expected {
+			t.Errorf("NextMonthDay(%s, %d) = %s; want %s", c.date, c.day, result, c.expected)
+		}
+	}
+}
+
 func TestEqualDate(t *testing.T) {
 	date1 := datetime.NewDate(2023, 4, 15)
 	date2 := datetime.NewDate(2023, 4, 15)
@@ -166,6 +657,73 @@ func TestMarshalJSON(t *testing.T) {
 	if err != nil || !newDate.EqualDate(date) {
 		t.Error("UnmarshalJSON failed")
 	}
+
+	jsonData, err = json.Marshal(datetime.EmptyDate)
+	if err != nil || string(jsonData) != "null" {
+		t.Errorf("MarshalJSON(EmptyDate) = %s, %v; want null", jsonData, err)
+	}
+
+	newDate = datetime.Date{}
+	err = json.Unmarshal(jsonData, &newDate)
+	if err != nil || !newDate.IsZero() {
+		t.Errorf("UnmarshalJSON(null) = %v, %v; want zero date", newDate, err)
+	}
+}
+
+func TestDateUnmarshalJSON(t *testing.T) {
+	cases := []struct {
+		input     string
+		expected  string
+		expectErr bool
+	}{
+		{`"2023-04-15"`, "2023-04-15", false},
+		{`"2023-04-15T10:30:00Z"`, "2023-04-15", false},
+		{`"2023-04-15T23:30:00-05:00"`, "2023-04-15", false},
+		{`"2023-04-15 10:30"`, "", true},
+		{`"invalid"`, "", true},
+	}
+
+	for _, c := range cases {
+		var date datetime.Date
+		err := json.Unmarshal([]byte(c.input), &date)
+		if (err != nil) != c.expectErr || (!c.expectErr && date.String() != c.expected) {
+			t.Errorf("UnmarshalJSON(%s) = %v, %v; want %v, %v", c.input, date, err, c.expected, c.expectErr)
+		}
+	}
+}
+
+func TestDateMarshalBinary(t *testing.T) {
+	cases := []datetime.Date{
+		datetime.NewDate(2023, 4, 15),
+		datetime.NewDate(2024, 2, 29),
+		datetime.NewDate(1, 1, 1),
+		datetime.NewDate(9999, 12, 31),
+		datetime.EmptyDate,
+	}
+
+	for _, c := range cases {
+		data, err := c.MarshalBinary()
+		if err != nil || len(data) != 4 {
+			t.Errorf("MarshalBinary(%s) = %v, %v; want 4 bytes", c, data, err)
+			continue
+		}
+		var result datetime.Date
+		if err := result.UnmarshalBinary(data); err != nil {
+			t.Errorf("UnmarshalBinary(%v) error = %v", data, err)
+			continue
+		}
+		if !result.Equal(c.Time) {
+			t.Errorf("UnmarshalBinary(%v) = %s; want %s", data, result, c)
+		}
+	}
+
+	var result datetime.Date
+	if err := result.UnmarshalBinary([]byte{0, 0, 0x01, 0xFF}); err == nil {
+		t.Error("UnmarshalBinary should fail for invalid month")
+	}
+	if err := result.UnmarshalBinary([]byte{0, 0}); err == nil {
+		t.Error("UnmarshalBinary should fail for invalid length")
+	}
 }
 
 func TestTransformDatesToString(t *testing.T) {
@@ -183,6 +741,180 @@ func TestTransformDatesToString(t *testing.T) {
 	}
 }
 
+func TestDatesFromTimes(t *testing.T) {
+	ts := []time.Time{
+		time.Date(2023, 4, 15, 10, 30, 0, 0, time.UTC),
+		time.Date(2022, 12, 31, 23, 59, 0, 0, time.UTC),
+		time.Date(2024, 2, 29, 1, 0, 0, 0, time.FixedZone("UTC+3", 3*3600)),
+	}
+	expected := []string{"2023-04-15", "2022-12-31", "2024-02-29"}
+
+	result := datetime.TransformDatesToString(datetime.DatesFromTimes(ts))
+	if strings.Join(result, ",") != strings.Join(expected, ",") {
+		t.Errorf("DatesFromTimes() = %v; want %v", result, expected)
+	}
+	if result := datetime.DatesFromTimes(nil); len(result) != 0 {
+		t.Errorf("DatesFromTimes(nil) = %v; want empty", result)
+	}
+}
+
+func TestMarshalDates(t *testing.T) {
+	dates := []datetime.Date{
+		datetime.NewDate(2023, 4, 15),
+		{},
+		datetime.NewDate(2022, 12, 31),
+	}
+	data, err := datetime.MarshalDates(dates)
+	if err != nil {
+		t.Fatalf("MarshalDates() error = %v", err)
+	}
+	if expected := `["2023-04-15","2022-12-31"]`; string(data) != expected {
+		t.Errorf("MarshalDates() = %s; want %s", data, expected)
+	}
+
+	if data, err := datetime.MarshalDates(nil); err != nil || string(data) != "[]" {
+		t.Errorf("MarshalDates(nil) = %s, %v; want []", data, err)
+	}
+}
+
+func TestUnmarshalDates(t *testing.T) {
+	result, err := datetime.UnmarshalDates([]byte(`["2023-04-15", null, "", "2022-12-31T10:00:00Z"]`))
+	if err != nil {
+		t.Fatalf("UnmarshalDates() error = %v", err)
+	}
+	expected := []string{"2023-04-15", "2022-12-31"}
+	if s := datetime.TransformDatesToString(result); strings.Join(s, ",") != strings.Join(expected, ",") {
+		t.Errorf("UnmarshalDates() = %v; want %v", s, expected)
+	}
+
+	dates := []datetime.Date{datetime.NewDate(2023, 4, 15), datetime.NewDate(2024, 2, 29)}
+	data, err := datetime.MarshalDates(dates)
+	if err != nil {
+		t.Fatalf("MarshalDates() error = %v", err)
+	}
+	result, err = datetime.UnmarshalDates(data)
+	if err != nil || len(result) != len(dates) || !result[0].EqualDate(dates[0]) || !result[1].EqualDate(dates[1]) {
+		t.Errorf("UnmarshalDates(MarshalDates()) = %v, %v; want %v", result, err, dates)
+	}
+
+	for _, input := range []string{`["2023-04-15", "2023-13-01"]`, `["2023-04-15", 5]`, `{"date": "2023-04-15"}`, `invalid`} {
+		if _, err := datetime.UnmarshalDates([]byte(input)); err == nil {
+			t.Errorf("UnmarshalDates(%s) error = nil; want error", input)
+		}
+	}
+}
+
+func TestDateInstants(t *testing.T) {
+	date := datetime.NewDate(2023, 4, 15)
+	dayStart := datetime.NewTime(4, 0)
+
+	start := date.StartInstant(dayStart, time.UTC)
+	end := date.EndInstant(dayStart, time.UTC)
+	if !start.Equal(time.Date(2023, 4, 15, 4, 0, 0, 0, time.UTC)) {
+		t.Errorf("StartInstant(UTC) = %v", start)
+	}
+	if !end.Equal(time.Date(2023, 4, 16, 3, 59, 59, 999999999, time.UTC)) {
+		t.Errorf("EndInstant(UTC) = %v", end)
+	}
+
+	loc := time.FixedZone("UTC+3", 3*3600)
+	startLoc := date.StartInstant(dayStart, loc)
+	endLoc := date.EndInstant(dayStart, loc)
+	if !startLoc.Equal(time.Date(2023, 4, 15, 1, 0, 0, 0, time.UTC)) {
+		t.Errorf("StartInstant(UTC+3) = %v", startLoc.UTC())
+	}
+	if diff := start.Sub(startLoc); diff != 3*time.Hour {
+		t.Errorf("StartInstant difference = %v; want %v", diff, 3*time.Hour)
+	}
+	if diff := end.Sub(endLoc); diff != 3*time.Hour {
+		t.Errorf("EndInstant difference = %v; want %v", diff, 3*time.Hour)
+	}
+
+	if !date.StartInstant(datetime.EmptyTime, nil).Equal(date.Time) {
+		t.Errorf("StartInstant(nil) = %v; want %v", date.StartInstant(datetime.EmptyTime, nil), date.Time)
+	}
+}
+
+func TestDateRangeSafe(t *testing.T) {
+	date := datetime.NewDate(2023, 4, 15)
+
+	r, err := date.RangeSafe(datetime.NewDate(2023, 4, 20))
+	if err != nil || r != 5 {
+		t.Errorf("RangeSafe() = %d, %v; want 5", r, err)
+	}
+	if _, err := date.RangeSafe(datetime.EmptyDate); err == nil {
+		t.Error("RangeSafe() should fail for empty argument")
+	}
+	if _, err := datetime.EmptyDate.RangeSafe(date); err == nil {
+		t.Error("RangeSafe() should fail for empty receiver")
+	}
+}
+
+func TestDateDiffComponents(t *testing.T) {
+	cases := []struct {
+		from, to            string
+		years, months, days int
+	}{
+		{"2023-04-15", "2023-04-15", 0, 0, 0},
+		{"2021-04-15", "2023-04-15", 2, 0, 0},
+		{"2020-02-29", "2021-02-28", 1, 0, 0},
+		{"2020-01-05", "2022-04-15", 2, 3, 10},
+		{"2023-01-20", "2023-03-10", 0, 1, 18},
+		{"2023-01-31", "2023-03-01", 0, 1, 1},
+		{"2022-12-25", "2023-01-05", 0, 0, 11},
+		{"2023-03-10", "2023-01-20", 0, 1, 18},
+	}
+
+	for _, c := range cases {
+		from, _ := datetime.NewDateFromString(c.from)
+		to, _ := datetime.NewDateFromString(c.to)
+		years, months, days := from.DiffComponents(to)
+		if years != c.years || months != c.months || days != c.days {
+			t.Errorf("DiffComponents(%s, %s) = %d, %d, %d; want %d, %d, %d", c.from, c.to, years, months, days, c.years, c.months, c.days)
+		}
+	}
+}
+
+func TestDateSince(t *testing.T) {
+	var (
+		loc      = time.FixedZone("UTC+3", 3*3600)
+		dayStart = datetime.NewTime(4, 0)
+		// 2023-04-15 10:30 in UTC+3
+		now = time.Date(2023, 4, 15, 7, 30, 0, 0, time.UTC)
+	)
+
+	cases := []struct {
+		id    string
+		date  datetime.Date
+		since time.Duration
+	}{
+		{"same_day", datetime.NewDate(2023, 4, 15), 6*time.Hour + 30*time.Minute},
+		{"past", datetime.NewDate(2023, 4, 13), 54*time.Hour + 30*time.Minute},
+		{"future", datetime.NewDate(2023, 4, 17), -(41*time.Hour + 30*time.Minute)},
+		{"next_day", datetime.NewDate(2023, 4, 16), -(17*time.Hour + 30*time.Minute)},
+	}
+	for _, c := range cases {
+		if d := c.date.SinceAt(now, dayStart, loc); d != c.since {
+			t.Errorf("%s: SinceAt() = %v; want %v", c.id, d, c.since)
+		}
+		if d := c.date.UntilAt(now, dayStart, loc); d != -c.since {
+			t.Errorf("%s: UntilAt() = %v; want %v", c.id, d, -c.since)
+		}
+	}
+
+	if d := datetime.NewDate(2023, 4, 15).SinceAt(now.Add(-6*time.Hour-30*time.Minute), dayStart, loc); d != 0 {
+		t.Errorf("SinceAt(start instant) = %v; want 0", d)
+	}
+
+	past := datetime.NewDateFromTime(time.Now().In(loc).AddDate(0, 0, -2))
+	if d := past.Since(dayStart, loc); d <= 0 {
+		t.Errorf("Since(past) = %v; want positive", d)
+	}
+	if d := past.Until(dayStart, loc); d >= 0 {
+		t.Errorf("Until(past) = %v; want negative", d)
+	}
+}
+
 func TestIsArgNextDay(t *testing.T) {
 	date := datetime.NewDate(2023, 4, 15)
 	if !date.IsArgNextDay(datetime.NewDate(2023, 4, 16)) {
@@ -275,3 +1007,273 @@ func TestDateRange(t *testing.T) {
 		}
 	}
 }
+
+func TestDateJulianDayNumber(t *testing.T) {
+	cases := []struct {
+		date datetime.Date
+		jdn  int
+	}{
+		{datetime.NewDate(2000, 1, 1), 2451545},
+		{datetime.NewDate(1970, 1, 1), 2440588},
+		{datetime.NewDate(2024, 2, 29), 2460370},
+		{datetime.NewDate(1582, 10, 15), 2299161},
+	}
+
+	for _, c := range cases {
+		if result := c.date.JulianDayNumber(); result != c.jdn {
+			t.Errorf("%s.JulianDayNumber() = %d; want %d", c.date, result, c.jdn)
+		}
+		if result := datetime.DateFromJulianDayNumber(c.jdn); !result.Equal(c.date.Time) {
+			t.Errorf("DateFromJulianDayNumber(%d) = %s; want %s", c.jdn, result, c.date)
+		}
+	}
+}
+
+func TestDateAge(t *testing.T) {
+	cases := []struct {
+		birth, asOf datetime.Date
+		expected    int
+	}{
+		{datetime.NewDate(2000, 5, 10), datetime.NewDate(2018, 5, 10), 18},
+		{datetime.NewDate(2000, 5, 10), datetime.NewDate(2018, 5, 9), 17},
+		{datetime.NewDate(2000, 2, 29), datetime.NewDate(2001, 2, 28), 0},
+		{datetime.NewDate(2000, 2, 29), datetime.NewDate(2001, 3, 1), 1},
+		{datetime.NewDate(2000, 5, 10), datetime.NewDate(1999, 5, 10), -1},
+	}
+
+	for _, c := range cases {
+		if result := c.birth.Age(c.asOf); result != c.expected {
+			t.Errorf("%s.Age(%s) = %d; want %d", c.birth, c.asOf, result, c.expected)
+		}
+	}
+}
+
+func TestAgeBucket(t *testing.T) {
+	today := datetime.NewDate(2023, 4, 15)
+	bounds := []int{18, 25, 35}
+	cases := []struct {
+		id       string
+		birth    datetime.Date
+		bounds   []int
+		expected string
+	}{
+		{"child", datetime.NewDate(2020, 1, 1), bounds, "0-17"},
+		{"almost_18", datetime.NewDate(2005, 4, 16), bounds, "0-17"},
+		{"exactly_18_today", datetime.NewDate(2005, 4, 15), bounds, "18-24"},
+		{"on_boundary", datetime.NewDate(1998, 4, 15), bounds, "25-34"},
+		{"last_bucket", datetime.NewDate(1950, 1, 1), bounds, "35+"},
+		{"empty_bounds", datetime.NewDate(1950, 1, 1), nil, "0+"},
+		{"not_born", datetime.NewDate(2024, 1, 1), bounds, ""},
+	}
+
+	for _, c := range cases {
+		if result := datetime.AgeBucket(c.birth, today, c.bounds); result != c.expected {
+			t.Errorf("%s -> AgeBucket() = %q; want %q", c.id, result, c.expected)
+		}
+	}
+}
+
+func TestDateRotationIndex(t *testing.T) {
+	anchor := datetime.NewDate(2023, 4, 10)
+	cases := []struct {
+		date     datetime.Date
+		length   int
+		expected int
+	}{
+		{anchor, 7, 0},
+		{datetime.NewDate(2023, 4, 16), 7, 0},
+		{datetime.NewDate(2023, 4, 17), 7, 1},
+		{datetime.NewDate(2023, 5, 10), 7, 4},
+		{datetime.NewDate(2023, 4, 9), 7, -1},
+		{datetime.NewDate(2023, 4, 3), 7, -1},
+		{datetime.NewDate(2023, 4, 2), 7, -2},
+		{datetime.NewDate(2023, 4, 12), 0, 0},
+	}
+
+	for _, c := range cases {
+		if result := c.date.RotationIndex(anchor, c.length); result != c.expected {
+			t.Errorf("%s.RotationIndex(%s, %d) = %d; want %d", c.date, anchor, c.length, result, c.expected)
+		}
+	}
+}
+
+func TestDateWeekOfMonth(t *testing.T) {
+	cases := []struct {
+		date     datetime.Date
+		firstDay time.Weekday
+		expected int
+	}{
+		// April 2023 starts on Saturday.
+		{datetime.NewDate(2023, 4, 1), time.Monday, 1},
+		{datetime.NewDate(2023, 4, 2), time.Monday, 1},
+		{datetime.NewDate(2023, 4, 3), time.Monday, 2},
+		{datetime.NewDate(2023, 4, 30), time.Monday, 5},
+		{datetime.NewDate(2023, 4, 1), time.Sunday, 1},
+		{datetime.NewDate(2023, 4, 2), time.Sunday, 2},
+		{datetime.NewDate(2023, 4, 30), time.Sunday, 6},
+		// May 2023 starts on Monday.
+		{datetime.NewDate(2023, 5, 1), time.Monday, 1},
+		{datetime.NewDate(2023, 5, 7), time.Monday, 1},
+		{datetime.NewDate(2023, 5, 31), time.Monday, 5},
+		{datetime.NewDate(2023, 5, 31), time.Sunday, 5},
+		// February 2015 starts on Sunday and has exactly 4 weeks.
+		{datetime.NewDate(2015, 2, 28), time.Sunday, 4},
+		{datetime.NewDate(2015, 2, 28), time.Monday, 5},
+	}
+
+	for _, c := range cases {
+		if result := c.date.WeekOfMonth(c.firstDay); result != c.expected {
+			t.Errorf("%s.WeekOfMonth(%s) = %d; want %d", c.date, c.firstDay, result, c.expected)
+		}
+	}
+}
+
+func TestDateMondaySunday(t *testing.T) {
+	cases := []struct {
+		date           datetime.Date
+		monday, sunday string
+	}{
+		{datetime.NewDate(2023, 4, 12), "2023-04-10", "2023-04-16"},
+		{datetime.NewDate(2023, 4, 10), "2023-04-10", "2023-04-16"},
+		{datetime.NewDate(2023, 4, 16), "2023-04-10", "2023-04-16"},
+		{datetime.NewDate(2023, 5, 3), "2023-05-01", "2023-05-07"},
+		{datetime.NewDate(2022, 12, 31), "2022-12-26", "2023-01-01"},
+	}
+
+	for _, c := range cases {
+		if result := c.date.Monday().String(); result != c.monday {
+			t.Errorf("%s.Monday() = %s; want %s", c.date, result, c.monday)
+		}
+		if result := c.date.Sunday().String(); result != c.sunday {
+			t.Errorf("%s.Sunday() = %s; want %s", c.date, result, c.sunday)
+		}
+	}
+}
+
+func TestMissingDates(t *testing.T) {
+	cases := []struct {
+		id       string
+		dates    []string
+		expected []string
+	}{
+		{"empty", nil, nil},
+		{"single", []string{"2023-04-15"}, nil},
+		{"contiguous", []string{"2023-04-13", "2023-04-14", "2023-04-15"}, nil},
+		{"unsorted_duplicates", []string{"2023-04-15", "2023-04-13", "2023-04-14", "2023-04-13"}, nil},
+		{"gap_in_middle", []string{"2023-04-10", "2023-04-11", "2023-04-14", "2023-04-15"}, []string{"2023-04-12", "2023-04-13"}},
+		{"month_boundary", []string{"2023-05-02", "2023-04-29", "2023-04-30"}, []string{"2023-05-01"}},
+		{"year_boundary", []string{"2022-12-30", "2023-01-02"}, []string{"2022-12-31", "2023-01-01"}},
+	}
+
+	for _, c := range cases {
+		dates := make([]datetime.Date, 0, len(c.dates))
+		for _, s := range c.dates {
+			d, err := datetime.NewDateFromString(s)
+			if err != nil {
+				t.Fatal(err)
+			}
+			dates = append(dates, d)
+		}
+		result := datetime.TransformDatesToString(datetime.MissingDates(dates))
+		if strings.Join(result, ",") != strings.Join(c.expected, ",") {
+			t.Errorf("%s -> MissingDates() = %v; want %v", c.id, result, c.expected)
+		}
+	}
+}
+
+func TestFirstDayOfNextMonthLastDayOfPrevMonth(t *testing.T) {
+	cases := []struct {
+		date      datetime.Date
+		nextFirst string
+		prevLast  string
+	}{
+		{datetime.NewDate(2023, 4, 15), "2023-05-01", "2023-03-31"},
+		{datetime.NewDate(2023, 12, 31), "2024-01-01", "2023-11-30"},
+		{datetime.NewDate(2024, 1, 1), "2024-02-01", "2023-12-31"},
+		{datetime.NewDate(2024, 3, 1), "2024-04-01", "2024-02-29"},
+		{datetime.NewDate(2023, 3, 31), "2023-04-01", "2023-02-28"},
+		{datetime.NewDate(2024, 2, 29), "2024-03-01", "2024-01-31"},
+	}
+
+	for _, c := range cases {
+		if result := c.date.FirstDayOfNextMonth().String(); result != c.nextFirst {
+			t.Errorf("%s.FirstDayOfNextMonth() = %s; want %s", c.date, result, c.nextFirst)
+		}
+		if result := c.date.LastDayOfPrevMonth().String(); result != c.prevLast {
+			t.Errorf("%s.LastDayOfPrevMonth() = %s; want %s", c.date, result, c.prevLast)
+		}
+	}
+}
+
+func TestDaysRemaining(t *testing.T) {
+	cases := []struct {
+		date        datetime.Date
+		month, year int
+	}{
+		{datetime.NewDate(2023, 4, 30), 0, 245},
+		{datetime.NewDate(2023, 4, 15), 15, 260},
+		{datetime.NewDate(2023, 1, 1), 30, 364},
+		{datetime.NewDate(2024, 1, 1), 30, 365},
+		{datetime.NewDate(2024, 2, 28), 1, 307},
+		{datetime.NewDate(2023, 2, 28), 0, 306},
+		{datetime.NewDate(2024, 12, 31), 0, 0},
+		{datetime.NewDate(2023, 12, 31), 0, 0},
+	}
+
+	for _, c := range cases {
+		if result := c.date.DaysRemainingInMonth(); result != c.month {
+			t.Errorf("%s.DaysRemainingInMonth() = %d; want %d", c.date, result, c.month)
+		}
+		if result := c.date.DaysRemainingInYear(); result != c.year {
+			t.Errorf("%s.DaysRemainingInYear() = %d; want %d", c.date, result, c.year)
+		}
+	}
+}
+
+func TestClosestDate(t *testing.T) {
+	target := datetime.NewDate(2023, 4, 15)
+	cases := []struct {
+		id         string
+		candidates []datetime.Date
+		expected   string
+		ok         bool
+	}{
+		{"empty", nil, "", false},
+		{"single", []datetime.Date{datetime.NewDate(2024, 1, 1)}, "2024-01-01", true},
+		{"exact", []datetime.Date{datetime.NewDate(2023, 4, 10), datetime.NewDate(2023, 4, 15), datetime.NewDate(2023, 4, 16)}, "2023-04-15", true},
+		{"nearest", []datetime.Date{datetime.NewDate(2023, 4, 1), datetime.NewDate(2023, 4, 18), datetime.NewDate(2023, 4, 11)}, "2023-04-18", true},
+		{"tie_earlier", []datetime.Date{datetime.NewDate(2023, 4, 17), datetime.NewDate(2023, 4, 13)}, "2023-04-13", true},
+		{"tie_earlier_first", []datetime.Date{datetime.NewDate(2023, 4, 13), datetime.NewDate(2023, 4, 17)}, "2023-04-13", true},
+	}
+
+	for _, c := range cases {
+		result, ok := datetime.ClosestDate(target, c.candidates)
+		if ok != c.ok || (ok && result.String() != c.expected) {
+			t.Errorf("%s -> ClosestDate() = %s, %t; want %s, %t", c.id, result, ok, c.expected, c.ok)
+		}
+	}
+}
+
+func TestDateAddDuration(t *testing.T) {
+	date := datetime.NewDate(2023, 4, 30)
+	cases := []struct {
+		d        time.Duration
+		expected string
+	}{
+		{0, "2023-04-30"},
+		{36 * time.Hour, "2023-05-01"},
+		{23 * time.Hour, "2023-04-30"},
+		{24 * time.Hour, "2023-05-01"},
+		{72*time.Hour - time.Nanosecond, "2023-05-02"},
+		{-23 * time.Hour, "2023-04-30"},
+		{-36 * time.Hour, "2023-04-29"},
+		{-48 * time.Hour, "2023-04-28"},
+		{366 * 24 * time.Hour, "2024-04-30"},
+	}
+
+	for _, c := range cases {
+		if result := date.AddDuration(c.d).String(); result != c.expected {
+			t.Errorf("AddDuration(%v) = %s; want %s", c.d, result, c.expected)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package datetime
+
+import (
+	"math/rand"
+	"sort"
+)
+
+// DateRange is a data structure to store range of dates. Both Start and End are inclusive.
+type DateRange struct {
+	Start Date `json:"start"`
+	End   Date `json:"end"`
+}
+
+// NewDateRange returns new date range, start and end are swapped if end is before start.
+func NewDateRange(start, end Date) DateRange {
+	if end.Before(start.Time) {
+		start, end = end, start
+	}
+	return DateRange{Start: start, End: end}
+}
+
+// Days returns number of days in range including both start and end.
+func (r DateRange) Days() int {
+	return r.Start.Range(r.End) + 1
+}
+
+// Contains returns true if date is inside range.
+func (r DateRange) Contains(d Date) bool {
+	return !d.Before(r.Start.Time) && !d.After(r.End.Time)
+}
+
+// ContainsDateTime returns true if dt is inside range from Start 00:00 to End 23:59 including both ends,
+// so any time of the last day is inside and midnight of the next day is outside.
+func (r DateRange) ContainsDateTime(dt DateTime) bool {
+	return r.Contains(dt.Date)
+}
+
+// Overlaps returns true if ranges have at least one common day.
+func (r DateRange) Overlaps(other DateRange) bool {
+	return !r.Start.After(other.End.Time) && !other.Start.After(r.End.Time)
+}
+
+// OverlapDays returns number of days shared by both ranges, 0 if ranges do not overlap.
+func (r DateRange) OverlapDays(other DateRange) int {
+	if !r.Overlaps(other) {
+		return 0
+	}
+	start, end := r.Start, r.End
+	if other.Start.After(start.Time) {
+		start = other.Start
+	}
+	if other.End.Before(end.Time) {
+		end = other.End
+	}
+	return start.Range(end) + 1
+}
+
+// Subtract returns parts of range that are not covered by any of excluded ranges.
+// Excluded ranges may overlap each other and go beyond the range.
+func (r DateRange) Subtract(excluded ...DateRange) []DateRange {
+	sorted := make([]DateRange, len(excluded))
+	copy(sorted, excluded)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Start.Before(sorted[j].Start.Time)
+	})
+
+	var (
+		out []DateRange
+		cur = r.Start
+	)
+	for _, ex := range sorted {
+		if ex.Start.After(r.End.Time) || cur.After(r.End.Time) {
+			break
+		}
+		if ex.End.Before(cur.Time) {
+			continue
+		}
+		if ex.Start.After(cur.Time) {
+			out = append(out, DateRange{Start: cur, End: ex.Start.PrevDay()})
+		}
+		cur = ex.End.NextDay()
+	}
+	if !cur.After(r.End.Time) {
+		out = append(out, DateRange{Start: cur, End: r.End})
+	}
+
+	return out
+}
+
+// Chunk splits range into consecutive ranges of up to days days each, the last one may be shorter.
+// Chunks cover the whole range without gaps and overlaps. It returns nil if days <= 0.
+func (r DateRange) Chunk(days int) []DateRange {
+	if days <= 0 {
+		return nil
+	}
+	out := make([]DateRange, 0, (r.Days()+days-1)/days)
+	for start := r.Start; !start.After(r.End.Time); {
+		end := start.AddDate(0, 0, days-1)
+		if end.After(r.End.Time) {
+			end = r.End.Time
+		}
+		out = append(out, DateRange{Start: start, End: NewDateFromTime(end)})
+		start = NewDateFromTime(end).NextDay()
+	}
+	return out
+}
+
+// DistributeDates returns n pseudo-random dates between start and end including both of them, sorted ascending.
+// Dates are chosen with PRNG seeded by seed, so the same arguments always give the same result.
+// Dates may repeat. It returns nil if n is not positive or any of dates is zero.
+func DistributeDates(start, end Date, n int, seed int64) []Date {
+	if n <= 0 || start.IsZero() || end.IsZero() {
+		return nil
+	}
+	r := NewDateRange(start, end)
+	days := r.Days()
+	rnd := rand.New(rand.NewSource(seed))
+
+	out := make([]Date, 0, n)
+	for i := 0; i < n; i++ {
+		out = append(out, NewDate(r.Start.Year(), int(r.Start.Month()), r.Start.Day()+rnd.Intn(days)))
+	}
+	SortDates(out, false)
+	return out
+}
@@ -0,0 +1,248 @@
+package datetime_test
+
+import (
+	"testing"
+
+	"github.com/maxbolgarin/datetime"
+)
+
+func newRange(start, end string) datetime.DateRange {
+	s, _ := datetime.NewDateFromString(start)
+	e, _ := datetime.NewDateFromString(end)
+	return datetime.NewDateRange(s, e)
+}
+
+func TestNewDateRange(t *testing.T) {
+	r := newRange("2023-04-20", "2023-04-10")
+	if r.Start.String() != "2023-04-10" || r.End.String() != "2023-04-20" {
+		t.Errorf("NewDateRange() = %s - %s; want 2023-04-10 - 2023-04-20", r.Start, r.End)
+	}
+	if r.Days() != 11 {
+		t.Errorf("Days() = %d; want 11", r.Days())
+	}
+	if !r.Contains(datetime.NewDate(2023, 4, 10)) || !r.Contains(datetime.NewDate(2023, 4, 20)) {
+		t.Error("Contains() should return true for range bounds")
+	}
+	if r.Contains(datetime.NewDate(2023, 4, 21)) || r.Contains(datetime.NewDate(2023, 4, 9)) {
+		t.Error("Contains() should return false for dates outside range")
+	}
+}
+
+func TestDateRangeSubtract(t *testing.T) {
+	base := newRange("2023-04-01", "2023-04-30")
+
+	cases := []struct {
+		id       string
+		excluded []datetime.DateRange
+		expected []string
+	}{
+		{
+			id:       "none",
+			expected: []string{"2023-04-01/2023-04-30"},
+		},
+		{
+			id:       "middle",
+			excluded: []datetime.DateRange{newRange("2023-04-10", "2023-04-12")},
+			expected: []string{"2023-04-01/2023-04-09", "2023-04-13/2023-04-30"},
+		},
+		{
+			id:       "start_edge",
+			excluded: []datetime.DateRange{newRange("2023-03-25", "2023-04-05")},
+			expected: []string{"2023-04-06/2023-04-30"},
+		},
+		{
+			id:       "end_edge",
+			excluded: []datetime.DateRange{newRange("2023-04-30", "2023-05-05")},
+			expected: []string{"2023-04-01/2023-04-29"},
+		},
+		{
+			id:       "full",
+			excluded: []datetime.DateRange{newRange("2023-03-01", "2023-05-31")},
+			expected: nil,
+		},
+		{
+			id: "overlapping",
+			excluded: []datetime.DateRange{
+				newRange("2023-04-20", "2023-04-25"),
+				newRange("2023-04-10", "2023-04-15"),
+				newRange("2023-04-12", "2023-04-21"),
+				newRange("2023-04-26", "2023-04-26"),
+			},
+			expected: []string{"2023-04-01/2023-04-09", "2023-04-27/2023-04-30"},
+		},
+		{
+			id:       "outside",
+			excluded: []datetime.DateRange{newRange("2023-05-10", "2023-05-12")},
+			expected: []string{"2023-04-01/2023-04-30"},
+		},
+	}
+
+	for _, c := range cases {
+		result := base.Subtract(c.excluded...)
+		if len(result) != len(c.expected) {
+			t.Errorf("%s -> Subtract() returned %d ranges; want %d", c.id, len(result), len(c.expected))
+			continue
+		}
+		for i, r := range result {
+			if s := r.Start.String() + "/" + r.End.String(); s != c.expected[i] {
+				t.Errorf("%s -> Subtract()[%d] = %s; want %s", c.id, i, s, c.expected[i])
+			}
+		}
+	}
+}
+
+func TestDateRangeContainsDateTime(t *testing.T) {
+	r := newRange("2023-04-10", "2023-04-20")
+	cases := []struct {
+		id       string
+		dt       datetime.DateTime
+		expected bool
+	}{
+		{"first_day_midnight", datetime.NewDateTime(datetime.NewDate(2023, 4, 10), datetime.NewTime(0, 0)), true},
+		{"middle", datetime.NewDateTime(datetime.NewDate(2023, 4, 15), datetime.NewTime(12, 30)), true},
+		{"last_day_end", datetime.NewDateTime(datetime.NewDate(2023, 4, 20), datetime.NewTime(23, 59)), true},
+		{"day_after", datetime.NewDateTime(datetime.NewDate(2023, 4, 21), datetime.NewTime(0, 0)), false},
+		{"day_before_end", datetime.NewDateTime(datetime.NewDate(2023, 4, 9), datetime.NewTime(23, 59)), false},
+	}
+
+	for _, c := range cases {
+		if result := r.ContainsDateTime(c.dt); result != c.expected {
+			t.Errorf("%s -> ContainsDateTime(%s) = %t; want %t", c.id, c.dt, result, c.expected)
+		}
+	}
+}
+
+func TestDateRangeOverlapDays(t *testing.T) {
+	base := newRange("2023-04-10", "2023-04-20")
+
+	cases := []struct {
+		id       string
+		other    datetime.DateRange
+		expected int
+	}{
+		{"partial_start", newRange("2023-04-05", "2023-04-12"), 3},
+		{"partial_end", newRange("2023-04-18", "2023-05-01"), 3},
+		{"containment", newRange("2023-04-12", "2023-04-14"), 3},
+		{"contained", newRange("2023-04-01", "2023-04-30"), 11},
+		{"same", base, 11},
+		{"single_day", newRange("2023-04-20", "2023-04-25"), 1},
+		{"disjoint", newRange("2023-04-21", "2023-04-25"), 0},
+		{"disjoint_before", newRange("2023-03-01", "2023-04-09"), 0},
+	}
+
+	for _, c := range cases {
+		if result := base.OverlapDays(c.other); result != c.expected {
+			t.Errorf("%s -> OverlapDays() = %d; want %d", c.id, result, c.expected)
+		}
+		if result := c.other.OverlapDays(base); result != c.expected {
+			t.Errorf("%s -> reversed OverlapDays() = %d; want %d", c.id, result, c.expected)
+		}
+		if result := base.Overlaps(c.other); result != (c.expected > 0) {
+			t.Errorf("%s -> Overlaps() = %v; want %v", c.id, result, c.expected > 0)
+		}
+	}
+}
+
+func TestDateRangeChunk(t *testing.T) {
+	cases := []struct {
+		id       string
+		r        datetime.DateRange
+		days     int
+		expected []string
+	}{
+		{
+			id:       "exact_multiple",
+			r:        newRange("2023-04-01", "2023-04-09"),
+			days:     3,
+			expected: []string{"2023-04-01/2023-04-03", "2023-04-04/2023-04-06", "2023-04-07/2023-04-09"},
+		},
+		{
+			id:       "remainder",
+			r:        newRange("2023-04-28", "2023-05-05"),
+			days:     5,
+			expected: []string{"2023-04-28/2023-05-02", "2023-05-03/2023-05-05"},
+		},
+		{
+			id:       "bigger_than_range",
+			r:        newRange("2023-04-01", "2023-04-02"),
+			days:     10,
+			expected: []string{"2023-04-01/2023-04-02"},
+		},
+		{
+			id:       "single_days",
+			r:        newRange("2023-04-01", "2023-04-02"),
+			days:     1,
+			expected: []string{"2023-04-01/2023-04-01", "2023-04-02/2023-04-02"},
+		},
+		{
+			id:       "zero_days",
+			r:        newRange("2023-04-01", "2023-04-09"),
+			days:     0,
+			expected: nil,
+		},
+		{
+			id:       "negative_days",
+			r:        newRange("2023-04-01", "2023-04-09"),
+			days:     -3,
+			expected: nil,
+		},
+	}
+
+	for _, c := range cases {
+		result := c.r.Chunk(c.days)
+		if len(result) != len(c.expected) {
+			t.Errorf("%s -> Chunk() returned %d ranges; want %d", c.id, len(result), len(c.expected))
+			continue
+		}
+		for i, r := range result {
+			if s := r.Start.String() + "/" + r.End.String(); s != c.expected[i] {
+				t.Errorf("%s -> Chunk()[%d] = %s; want %s", c.id, i, s, c.expected[i])
+			}
+		}
+	}
+}
+
+func TestDistributeDates(t *testing.T) {
+	r := newRange("2023-04-01", "2023-04-30")
+
+	first := datetime.DistributeDates(r.Start, r.End, 100, 42)
+	second := datetime.DistributeDates(r.Start, r.End, 100, 42)
+	if len(first) != 100 || len(second) != 100 {
+		t.Fatalf("DistributeDates() returned %d and %d dates; want 100", len(first), len(second))
+	}
+	for i := range first {
+		if !first[i].EqualDate(second[i]) {
+			t.Errorf("DistributeDates()[%d] = %s and %s for the same seed", i, first[i], second[i])
+		}
+		if !r.Contains(first[i]) {
+			t.Errorf("DistributeDates()[%d] = %s is out of range", i, first[i])
+		}
+		if i > 0 && first[i].Before(first[i-1].Time) {
+			t.Errorf("DistributeDates()[%d] = %s is before %s", i, first[i], first[i-1])
+		}
+	}
+
+	reversed := datetime.DistributeDates(r.End, r.Start, 100, 42)
+	for i := range reversed {
+		if !reversed[i].EqualDate(first[i]) {
+			t.Errorf("DistributeDates(reversed)[%d] = %s; want %s", i, reversed[i], first[i])
+		}
+	}
+
+	single := datetime.DistributeDates(r.Start, r.Start, 3, 1)
+	for _, d := range single {
+		if !d.EqualDate(r.Start) {
+			t.Errorf("DistributeDates(single day) = %s; want %s", d, r.Start)
+		}
+	}
+
+	if result := datetime.DistributeDates(r.Start, r.End, 0, 42); result != nil {
+		t.Errorf("DistributeDates(n=0) = %v; want nil", result)
+	}
+	if result := datetime.DistributeDates(r.Start, r.End, -1, 42); result != nil {
+		t.Errorf("DistributeDates(n=-1) = %v; want nil", result)
+	}
+	if result := datetime.DistributeDates(datetime.Date{}, r.End, 5, 42); result != nil {
+		t.Errorf("DistributeDates(zero start) = %v; want nil", result)
+	}
+}
@@ -0,0 +1,36 @@
+package datetime
+
+import "time"
+
+// DateTime is a data structure to store date and time of day together without timezone.
+type DateTime struct {
+	Date Date `json:"date"`
+	Time Time `json:"time"`
+}
+
+// NewDateTime returns new DateTime from date and time.
+func NewDateTime(d Date, t Time) DateTime {
+	return DateTime{Date: d, Time: t}
+}
+
+// NewDateTimeFromTime returns new DateTime from time.Time.
+func NewDateTimeFromTime(t time.Time) DateTime {
+	return DateTime{Date: NewDateFromTime(t), Time: NewFromTime(t)}
+}
+
+// String returns DateTime in yyyy-mm-dd HH:MM format.
+func (dt DateTime) String() string {
+	return dt.Date.String() + " " + dt.Time.String()
+}
+
+// Add returns DateTime moved by d, date is rolled forward or backward when time crosses midnight,
+// e.g. 2023-04-15 22:00 plus 30 hours is 2023-04-17 04:00. Seconds that don't form a full minute are dropped.
+func (dt DateTime) Add(d time.Duration) DateTime {
+	t, days := dt.Time.ShiftByOffset(int(d / time.Second))
+	return DateTime{Date: NewDate(dt.Date.Year(), int(dt.Date.Month()), dt.Date.Day()+days), Time: t}
+}
+
+// minutes returns number of minutes since Unix epoch.
+func (dt DateTime) minutes() int64 {
+	return dt.Date.Unix()/60 + int64(dt.Time.Key())
+}
@@ -0,0 +1,54 @@
+package datetime_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maxbolgarin/datetime"
+)
+
+func TestNewDateTime(t *testing.T) {
+	dt := datetime.NewDateTime(datetime.NewDate(2023, 4, 15), datetime.NewTime(10, 30))
+	if dt.String() != "2023-04-15 10:30" {
+		t.Errorf("String() = %s; want 2023-04-15 10:30", dt)
+	}
+
+	dt = datetime.NewDateTimeFromTime(time.Date(2023, 4, 15, 23, 59, 30, 0, time.UTC))
+	if dt.String() != "2023-04-15 23:59" {
+		t.Errorf("NewDateTimeFromTime() = %s; want 2023-04-15 23:59", dt)
+	}
+}
+
+func TestDateTimeAdd(t *testing.T) {
+	cases := []struct {
+		id       string
+		dt       datetime.DateTime
+		d        time.Duration
+		expected string
+	}{
+		{"same_day", datetime.NewDateTime(datetime.NewDate(2023, 4, 15), datetime.NewTime(10, 0)), 2 * time.Hour, "2023-04-15 12:00"},
+		{"plus_30h", datetime.NewDateTime(datetime.NewDate(2023, 4, 15), datetime.NewTime(10, 0)), 30 * time.Hour, "2023-04-16 16:00"},
+		{"plus_30h_twice_midnight", datetime.NewDateTime(datetime.NewDate(2023, 4, 15), datetime.NewTime(22, 0)), 30 * time.Hour, "2023-04-17 04:00"},
+		{"to_midnight", datetime.NewDateTime(datetime.NewDate(2023, 4, 15), datetime.NewTime(22, 0)), 2 * time.Hour, "2023-04-16 00:00"},
+		{"minus_month_boundary", datetime.NewDateTime(datetime.NewDate(2023, 5, 1), datetime.NewTime(2, 0)), -3 * time.Hour, "2023-04-30 23:00"},
+		{"minus_year_boundary", datetime.NewDateTime(datetime.NewDate(2023, 1, 1), datetime.NewTime(0, 0)), -49 * time.Hour, "2022-12-29 23:00"},
+		{"seconds_dropped", datetime.NewDateTime(datetime.NewDate(2023, 4, 15), datetime.NewTime(10, 0)), 90 * time.Second, "2023-04-15 10:01"},
+	}
+
+	for _, c := range cases {
+		if result := c.dt.Add(c.d).String(); result != c.expected {
+			t.Errorf("%s -> Add(%v) = %s; want %s", c.id, c.d, result, c.expected)
+		}
+	}
+
+	var (
+		dt     = datetime.NewDateTime(datetime.NewDate(2023, 4, 10), datetime.NewTime(9, 0))
+		shifts = []time.Duration{8 * time.Hour, 10*time.Hour + 30*time.Minute, 12 * time.Hour, -6 * time.Hour, 40 * time.Hour}
+	)
+	for _, d := range shifts {
+		dt = dt.Add(d)
+	}
+	if dt.String() != "2023-04-13 01:30" {
+		t.Errorf("Add() accumulated = %s; want 2023-04-13 01:30", dt)
+	}
+}
@@ -0,0 +1,175 @@
+package datetime
+
+import (
+	"sort"
+	"time"
+)
+
+// Interval is a data structure to store range of time of day from Start to End.
+// If End is before Start, interval crosses midnight. If End equals Start, interval covers the whole day.
+type Interval struct {
+	Start Time `json:"start"`
+	End   Time `json:"end"`
+}
+
+// NewInterval returns new interval from start and end times.
+func NewInterval(start, end Time) Interval {
+	return Interval{Start: start, End: end}
+}
+
+// IsWrapping returns true if interval crosses midnight.
+// Whole day interval crosses midnight unless it starts at 00:00.
+func (i Interval) IsWrapping() bool {
+	return i.End.IsBeforeStrict(i.Start) || (i.IsFullDay() && i.Start.Key() != 0)
+}
+
+// IsFullDay returns true if interval covers the whole day, it is when End equals Start, e.g. 00:00-00:00.
+func (i Interval) IsFullDay() bool {
+	return i.Start.EqualTime(i.End)
+}
+
+// Canonical returns start and end of interval with flag that is true if interval crosses midnight,
+// so interval can be stored where wrapping is not supported.
+func (i Interval) Canonical() (start, end Time, wraps bool) {
+	return i.Start, i.End, i.IsWrapping()
+}
+
+// Duration returns length of interval, it is 24h for the whole day interval.
+func (i Interval) Duration() time.Duration {
+	return i.Start.SmartDiffNonZero(i.End)
+}
+
+// Contains returns true if t is inside interval. Start is inclusive and end is exclusive.
+func (i Interval) Contains(t Time) bool {
+	if i.IsFullDay() {
+		return true
+	}
+	if i.IsWrapping() {
+		return t.IsAfter(i.Start) || t.IsBeforeStrict(i.End)
+	}
+	return t.IsAfter(i.Start) && t.IsBeforeStrict(i.End)
+}
+
+// Shift returns interval moved by d, both start and end wrap across midnight.
+// Negative d moves interval earlier.
+func (i Interval) Shift(d time.Duration) Interval {
+	return Interval{Start: shiftTime(i.Start, d), End: shiftTime(i.End, d)}
+}
+
+// Extend returns interval with end moved by d, end wraps across midnight.
+// Negative d shortens interval.
+func (i Interval) Extend(d time.Duration) Interval {
+	return Interval{Start: i.Start, End: shiftTime(i.End, d)}
+}
+
+func shiftTime(t Time, d time.Duration) Time {
+	if d < 0 {
+		return t.SubTime(-d)
+	}
+	return t.AddTime(d)
+}
+
+// ClampTo returns part of interval that is inside bounds and true if they overlap.
+// Intervals crossing midnight are split at midnight before intersecting. If intersection consists
+// of two separate parts, it cannot be represented as a single Interval and false is returned.
+func (i Interval) ClampTo(bounds Interval) (Interval, bool) {
+	var parts []Interval
+	for _, a := range i.segments() {
+		for _, b := range bounds.segments() {
+			start, end := a.start, a.end
+			if b.start > start {
+				start = b.start
+			}
+			if b.end < end {
+				end = b.end
+			}
+			if start < end {
+				parts = append(parts, segment{start, end}.interval())
+			}
+		}
+	}
+
+	parts = MergeIntervals(parts)
+	if len(parts) != 1 {
+		return Interval{}, false
+	}
+	return parts[0], true
+}
+
+// SpannedDates returns calendar dates that interval starting on start date touches:
+// one date for intervals within a day and two dates for intervals crossing midnight.
+// End is exclusive, so interval ending exactly at 00:00 doesn't touch the next date.
+func SpannedDates(start Date, iv Interval) []Date {
+	if iv.IsWrapping() && iv.End.Key() != 0 {
+		return []Date{start, start.NextDay()}
+	}
+	return []Date{start}
+}
+
+// MergeIntervals returns sorted minimal set of intervals covering the same times as provided ones.
+// Overlapping and touching intervals are merged into one. Intervals crossing midnight are split
+// at midnight before merging and joined back if the result still crosses midnight.
+// Intervals covering the whole day result in a single 00:00-00:00 interval, see IsFullDay.
+func MergeIntervals(intervals []Interval) []Interval {
+	segments := make([]segment, 0, len(intervals)+1)
+	for _, iv := range intervals {
+		segments = append(segments, iv.segments()...)
+	}
+	if len(segments) == 0 {
+		return nil
+	}
+
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].start < segments[j].start
+	})
+
+	merged := segments[:1]
+	for _, seg := range segments[1:] {
+		last := &merged[len(merged)-1]
+		if seg.start <= last.end {
+			if seg.end > last.end {
+				last.end = seg.end
+			}
+			continue
+		}
+		merged = append(merged, seg)
+	}
+
+	if last := len(merged) - 1; last > 0 && merged[0].start == 0 && merged[last].end == minutesInDay {
+		merged[last].end = merged[0].end
+		merged = merged[1:]
+	}
+
+	out := make([]Interval, 0, len(merged))
+	for _, seg := range merged {
+		out = append(out, seg.interval())
+	}
+	return out
+}
+
+// segment is a part of interval in minutes from the beginning of the day, end is in range [1, 1440].
+type segment struct {
+	start, end int
+}
+
+func (s segment) interval() Interval {
+	end := s.end % minutesInDay
+	return Interval{
+		Start: NewTime(s.start/60, s.start%60),
+		End:   NewTime(end/60, end%60),
+	}
+}
+
+// segments returns interval split at midnight.
+func (i Interval) segments() []segment {
+	start := i.Start.MinutesFromDayBegin(EmptyTime)
+	end := i.End.MinutesFromDayBegin(EmptyTime)
+	switch {
+	case start == 0 && end == 0:
+		return []segment{{0, minutesInDay}}
+	case end <= start:
+		return []segment{{start, minutesInDay}, {0, end}}
+	default:
+		return []segment{{start, end}}
+	}
+}
@@ -0,0 +1,284 @@
+package datetime_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maxbolgarin/datetime"
+)
+
+func TestIntervalContains(t *testing.T) {
+	day := datetime.NewInterval(datetime.NewTime(9, 0), datetime.NewTime(17, 0))
+	night := datetime.NewInterval(datetime.NewTime(22, 0), datetime.NewTime(2, 0))
+
+	cases := []struct {
+		interval datetime.Interval
+		input    datetime.Time
+		expected bool
+	}{
+		{day, datetime.NewTime(9, 0), true},
+		{day, datetime.NewTime(12, 30), true},
+		{day, datetime.NewTime(17, 0), false},
+		{day, datetime.NewTime(8, 59), false},
+		{night, datetime.NewTime(22, 0), true},
+		{night, datetime.NewTime(23, 59), true},
+		{night, datetime.NewTime(0, 0), true},
+		{night, datetime.NewTime(2, 0), false},
+		{night, datetime.NewTime(12, 0), false},
+		{datetime.NewInterval(datetime.NewTime(0, 0), datetime.NewTime(0, 0)), datetime.NewTime(6, 0), true},
+		{datetime.NewInterval(datetime.NewTime(0, 0), datetime.NewTime(0, 0)), datetime.NewTime(23, 59), true},
+		{datetime.NewInterval(datetime.NewTime(10, 0), datetime.NewTime(10, 0)), datetime.NewTime(9, 59), true},
+	}
+
+	for _, c := range cases {
+		if result := c.interval.Contains(c.input); result != c.expected {
+			t.Errorf("Interval(%s-%s).Contains(%s) = %v; want %v", c.interval.Start, c.interval.End, c.input, result, c.expected)
+		}
+	}
+}
+
+func TestIntervalCanonical(t *testing.T) {
+	cases := []struct {
+		interval datetime.Interval
+		wraps    bool
+	}{
+		{datetime.NewInterval(datetime.NewTime(9, 0), datetime.NewTime(18, 0)), false},
+		{datetime.NewInterval(datetime.NewTime(22, 0), datetime.NewTime(2, 0)), true},
+		{datetime.NewInterval(datetime.NewTime(10, 0), datetime.NewTime(10, 0)), true},
+		{datetime.NewInterval(datetime.NewTime(0, 0), datetime.NewTime(0, 0)), false},
+	}
+
+	for _, c := range cases {
+		start, end, wraps := c.interval.Canonical()
+		if !start.EqualTime(c.interval.Start) || !end.EqualTime(c.interval.End) || wraps != c.wraps {
+			t.Errorf("Canonical(%s-%s) = %s, %s, %t; want %s, %s, %t", c.interval.Start, c.interval.End,
+				start, end, wraps, c.interval.Start, c.interval.End, c.wraps)
+		}
+	}
+}
+
+func TestIntervalDuration(t *testing.T) {
+	day := datetime.NewInterval(datetime.NewTime(9, 0), datetime.NewTime(17, 30))
+	if d := day.Duration(); d != 8*time.Hour+30*time.Minute {
+		t.Errorf("Duration() = %v; want %v", d, 8*time.Hour+30*time.Minute)
+	}
+	if day.IsWrapping() {
+		t.Error("IsWrapping() should return false for same day interval")
+	}
+
+	night := datetime.NewInterval(datetime.NewTime(22, 0), datetime.NewTime(2, 0))
+	if d := night.Duration(); d != 4*time.Hour {
+		t.Errorf("Duration() = %v; want %v", d, 4*time.Hour)
+	}
+	if !night.IsWrapping() {
+		t.Error("IsWrapping() should return true for interval crossing midnight")
+	}
+
+	full := datetime.NewInterval(datetime.NewTime(0, 0), datetime.NewTime(0, 0))
+	if d := full.Duration(); d != 24*time.Hour || !full.IsFullDay() || full.IsWrapping() {
+		t.Errorf("00:00-00:00 Duration() = %v, IsFullDay() = %t, IsWrapping() = %t; want 24h, true, false",
+			d, full.IsFullDay(), full.IsWrapping())
+	}
+	full = datetime.NewInterval(datetime.NewTime(10, 0), datetime.NewTime(10, 0))
+	if d := full.Duration(); d != 24*time.Hour || !full.IsFullDay() || !full.IsWrapping() {
+		t.Errorf("10:00-10:00 Duration() = %v, IsFullDay() = %t, IsWrapping() = %t; want 24h, true, true",
+			d, full.IsFullDay(), full.IsWrapping())
+	}
+	if day.IsFullDay() || night.IsFullDay() {
+		t.Error("IsFullDay() should return false for partial intervals")
+	}
+}
+
+func TestIntervalShift(t *testing.T) {
+	iv := datetime.NewInterval(datetime.NewTime(21, 0), datetime.NewTime(23, 30))
+
+	cases := []struct {
+		shift      time.Duration
+		start, end string
+		isWrapping bool
+	}{
+		{time.Hour, "22:00", "00:30", true},
+		{3 * time.Hour, "00:00", "02:30", false},
+		{-2 * time.Hour, "19:00", "21:30", false},
+		{0, "21:00", "23:30", false},
+	}
+
+	for _, c := range cases {
+		result := iv.Shift(c.shift)
+		if result.Start.String() != c.start || result.End.String() != c.end || result.IsWrapping() != c.isWrapping {
+			t.Errorf("Shift(%v) = %s-%s (wrap %v); want %s-%s (wrap %v)", c.shift,
+				result.Start, result.End, result.IsWrapping(), c.start, c.end, c.isWrapping)
+		}
+		if result.Duration() != iv.Duration() {
+			t.Errorf("Shift(%v) changed duration to %v", c.shift, result.Duration())
+		}
+	}
+}
+
+func TestIntervalExtend(t *testing.T) {
+	iv := datetime.NewInterval(datetime.NewTime(22, 0), datetime.NewTime(23, 30))
+
+	result := iv.Extend(2 * time.Hour)
+	if result.Start.String() != "22:00" || result.End.String() != "01:30" || !result.IsWrapping() {
+		t.Errorf("Extend(2h) = %s-%s; want 22:00-01:30", result.Start, result.End)
+	}
+	if result.Duration() != 3*time.Hour+30*time.Minute {
+		t.Errorf("Extend(2h) duration = %v; want %v", result.Duration(), 3*time.Hour+30*time.Minute)
+	}
+
+	result = iv.Extend(-time.Hour)
+	if result.Start.String() != "22:00" || result.End.String() != "22:30" {
+		t.Errorf("Extend(-1h) = %s-%s; want 22:00-22:30", result.Start, result.End)
+	}
+}
+
+func TestSpannedDates(t *testing.T) {
+	start := datetime.NewDate(2023, 4, 15)
+	cases := []struct {
+		id       string
+		interval datetime.Interval
+		expected []string
+	}{
+		{"same_day", datetime.NewInterval(datetime.NewTime(9, 0), datetime.NewTime(18, 0)), []string{"2023-04-15"}},
+		{"wrap", datetime.NewInterval(datetime.NewTime(22, 0), datetime.NewTime(6, 0)), []string{"2023-04-15", "2023-04-16"}},
+		{"till_midnight", datetime.NewInterval(datetime.NewTime(22, 0), datetime.NewTime(0, 0)), []string{"2023-04-15"}},
+		{"from_midnight", datetime.NewInterval(datetime.NewTime(0, 0), datetime.NewTime(1, 0)), []string{"2023-04-15"}},
+		{"full_day", datetime.NewInterval(datetime.NewTime(0, 0), datetime.NewTime(0, 0)), []string{"2023-04-15"}},
+		{"full_day_wrap", datetime.NewInterval(datetime.NewTime(10, 0), datetime.NewTime(10, 0)), []string{"2023-04-15", "2023-04-16"}},
+	}
+
+	for _, c := range cases {
+		result := datetime.TransformDatesToString(datetime.SpannedDates(start, c.interval))
+		if strings.Join(result, ",") != strings.Join(c.expected, ",") {
+			t.Errorf("%s -> SpannedDates() = %v; want %v", c.id, result, c.expected)
+		}
+	}
+}
+
+func TestMergeIntervals(t *testing.T) {
+	iv := func(sh, sm, eh, em int) datetime.Interval {
+		return datetime.NewInterval(datetime.NewTime(sh, sm), datetime.NewTime(eh, em))
+	}
+
+	cases := []struct {
+		id       string
+		input    []datetime.Interval
+		expected []string
+	}{
+		{
+			id:       "empty",
+			expected: nil,
+		},
+		{
+			id:       "touching",
+			input:    []datetime.Interval{iv(10, 0, 12, 0), iv(9, 0, 10, 0), iv(12, 0, 13, 30)},
+			expected: []string{"09:00-13:30"},
+		},
+		{
+			id:       "overlapping",
+			input:    []datetime.Interval{iv(9, 0, 11, 0), iv(10, 0, 10, 30), iv(14, 0, 16, 0), iv(15, 0, 17, 0)},
+			expected: []string{"09:00-11:00", "14:00-17:00"},
+		},
+		{
+			id:       "wrap_with_morning",
+			input:    []datetime.Interval{iv(22, 0, 2, 0), iv(1, 0, 5, 0), iv(12, 0, 13, 0)},
+			expected: []string{"12:00-13:00", "22:00-05:00"},
+		},
+		{
+			id:       "wrap_with_evening",
+			input:    []datetime.Interval{iv(23, 0, 1, 0), iv(20, 0, 23, 0)},
+			expected: []string{"20:00-01:00"},
+		},
+		{
+			id:       "until_midnight",
+			input:    []datetime.Interval{iv(20, 0, 0, 0), iv(0, 0, 3, 0)},
+			expected: []string{"20:00-03:00"},
+		},
+		{
+			id:       "full_day_halves",
+			input:    []datetime.Interval{iv(0, 0, 12, 0), iv(12, 0, 0, 0)},
+			expected: []string{"00:00-00:00"},
+		},
+		{
+			id:       "full_day_wrap",
+			input:    []datetime.Interval{iv(20, 0, 8, 0), iv(8, 0, 20, 0)},
+			expected: []string{"00:00-00:00"},
+		},
+		{
+			id:       "full_day",
+			input:    []datetime.Interval{iv(0, 0, 0, 0)},
+			expected: []string{"00:00-00:00"},
+		},
+		{
+			id:       "full_day_same_time",
+			input:    []datetime.Interval{iv(10, 0, 10, 0), iv(12, 0, 13, 0)},
+			expected: []string{"00:00-00:00"},
+		},
+	}
+
+	for _, c := range cases {
+		result := datetime.MergeIntervals(c.input)
+		if len(result) != len(c.expected) {
+			t.Errorf("%s -> MergeIntervals() returned %d intervals; want %d", c.id, len(result), len(c.expected))
+			continue
+		}
+		for i, r := range result {
+			if s := r.Start.String() + "-" + r.End.String(); s != c.expected[i] {
+				t.Errorf("%s -> MergeIntervals()[%d] = %s; want %s", c.id, i, s, c.expected[i])
+			}
+		}
+
+		again := datetime.MergeIntervals(result)
+		if len(again) != len(result) {
+			t.Errorf("%s -> MergeIntervals(MergeIntervals()) returned %d intervals; want %d", c.id, len(again), len(result))
+			continue
+		}
+		for i := range again {
+			if again[i] != result[i] {
+				t.Errorf("%s -> MergeIntervals(MergeIntervals())[%d] = %s-%s; want %s-%s", c.id, i,
+					again[i].Start, again[i].End, result[i].Start, result[i].End)
+			}
+		}
+	}
+}
+
+func TestIntervalClampTo(t *testing.T) {
+	iv := func(sh, sm, eh, em int) datetime.Interval {
+		return datetime.NewInterval(datetime.NewTime(sh, sm), datetime.NewTime(eh, em))
+	}
+
+	cases := []struct {
+		id       string
+		input    datetime.Interval
+		bounds   datetime.Interval
+		expected string
+		ok       bool
+	}{
+		{"contained", iv(10, 0, 12, 0), iv(9, 0, 18, 0), "10:00-12:00", true},
+		{"partial_start", iv(8, 0, 10, 0), iv(9, 0, 18, 0), "09:00-10:00", true},
+		{"partial_end", iv(17, 0, 19, 0), iv(9, 0, 18, 0), "17:00-18:00", true},
+		{"covering", iv(7, 0, 20, 0), iv(9, 0, 18, 0), "09:00-18:00", true},
+		{"no_overlap", iv(19, 0, 20, 0), iv(9, 0, 18, 0), "", false},
+		{"touching", iv(18, 0, 20, 0), iv(9, 0, 18, 0), "", false},
+		{"wrap_bounds", iv(21, 0, 23, 0), iv(22, 0, 6, 0), "22:00-23:00", true},
+		{"wrap_both", iv(23, 0, 7, 0), iv(22, 0, 6, 0), "23:00-06:00", true},
+		{"wrap_input", iv(20, 0, 2, 0), iv(0, 0, 12, 0), "00:00-02:00", true},
+		{"two_parts", iv(20, 0, 10, 0), iv(8, 0, 22, 0), "", false},
+		{"two_parts_wrap_bounds", iv(5, 0, 23, 0), iv(22, 0, 6, 0), "", false},
+	}
+
+	for _, c := range cases {
+		result, ok := c.input.ClampTo(c.bounds)
+		if ok != c.ok {
+			t.Errorf("%s -> ClampTo() ok = %v; want %v", c.id, ok, c.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if s := result.Start.String() + "-" + result.End.String(); s != c.expected {
+			t.Errorf("%s -> ClampTo() = %s; want %s", c.id, s, c.expected)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package datetime
+
+import "strings"
+
+// locale is a data structure to store localized names of months and weekdays.
+type locale struct {
+	months        [12]string
+	monthsShort   [12]string
+	weekdays      [7]string
+	weekdaysShort [7]string
+}
+
+const defaultLang = "en"
+
+var locales = map[string]locale{
+	"en": {
+		months: [12]string{
+			"January", "February", "March", "April", "May", "June",
+			"July", "August", "September", "October", "November", "December",
+		},
+		monthsShort: [12]string{
+			"Jan", "Feb", "Mar", "Apr", "May", "Jun",
+			"Jul", "Aug", "Sep", "Oct", "Nov", "Dec",
+		},
+		weekdays: [7]string{
+			"Sunday", "Monday", "Tuesday", "Wednesday", "Thursday", "Friday", "Saturday",
+		},
+		weekdaysShort: [7]string{
+			"Sun", "Mon", "Tue", "Wed", "Thu", "Fri", "Sat",
+		},
+	},
+	"ru": {
+		months: [12]string{
+			"Январь", "Февраль", "Март", "Апрель", "Май", "Июнь",
+			"Июль", "Август", "Сентябрь", "Октябрь", "Ноябрь", "Декабрь",
+		},
+		monthsShort: [12]string{
+			"Янв", "Фев", "Мар", "Апр", "Май", "Июн",
+			"Июл", "Авг", "Сен", "Окт", "Ноя", "Дек",
+		},
+		weekdays: [7]string{
+			"Воскресенье", "Понедельник", "Вторник", "Среда", "Четверг", "Пятница", "Суббота",
+		},
+		weekdaysShort: [7]string{
+			"Вс", "Пн", "Вт", "Ср", "Чт", "Пт", "Сб",
+		},
+	},
+}
+
+// getLocale returns locale for the language or English locale if language is unknown.
+func getLocale(lang string) locale {
+	if l, ok := locales[strings.ToLower(lang)]; ok {
+		return l
+	}
+	return locales[defaultLang]
+}
@@ -0,0 +1,81 @@
+package datetime
+
+import "time"
+
+// Frequencies of Recurrence.
+const (
+	FreqDaily   = "DAILY"
+	FreqWeekly  = "WEEKLY"
+	FreqMonthly = "MONTHLY"
+)
+
+// Recurrence is a simple recurrence rule, a small subset of iCalendar RRULE.
+// Freq is one of FreqDaily, FreqWeekly or FreqMonthly, Interval is a number of days, weeks or months
+// between occurrences (1 if not positive). ByWeekday is used only with FreqWeekly and
+// selects days of every Interval-th week, weeks start on Monday.
+type Recurrence struct {
+	Freq      string         `json:"freq"`
+	Interval  int            `json:"interval"`
+	ByWeekday []time.Weekday `json:"by_weekday,omitempty"`
+}
+
+// Occurrences returns first count dates of recurrence starting from start.
+// Start is the first occurrence unless ByWeekday is set and doesn't contain its weekday.
+// Monthly occurrences are clamped to the end of month, e.g. January 31 is followed by February 28.
+// It returns nil for unknown Freq, weekday in ByWeekday out of range [Sunday, Saturday] or if count is not positive.
+func (r Recurrence) Occurrences(start Date, count int) []Date {
+	if count <= 0 {
+		return nil
+	}
+	interval := r.Interval
+	if interval <= 0 {
+		interval = 1
+	}
+
+	out := make([]Date, 0, count)
+	switch r.Freq {
+	case FreqDaily:
+		for i := 0; i < count; i++ {
+			out = append(out, NewDate(start.Year(), int(start.Month()), start.Day()+i*interval))
+		}
+	case FreqWeekly:
+		if len(r.ByWeekday) == 0 {
+			for i := 0; i < count; i++ {
+				out = append(out, NewDate(start.Year(), int(start.Month()), start.Day()+i*7*interval))
+			}
+			break
+		}
+		for _, wd := range r.ByWeekday {
+			if wd < time.Sunday || wd > time.Saturday {
+				return nil
+			}
+		}
+		// Every week except the first partial one has at least one occurrence.
+		monday := start.Monday()
+		for week := 0; len(out) < count && week <= (count+1)*interval; week += interval {
+			for day := 0; day < 7 && len(out) < count; day++ {
+				d := NewDate(monday.Year(), int(monday.Month()), monday.Day()+week*7+day)
+				if !d.Before(start.Time) && r.hasWeekday(d.Weekday()) {
+					out = append(out, d)
+				}
+			}
+		}
+	case FreqMonthly:
+		for i := 0; i < count; i++ {
+			out = append(out, addMonthsClamped(start, i*interval))
+		}
+	default:
+		return nil
+	}
+
+	return out
+}
+
+func (r Recurrence) hasWeekday(w time.Weekday) bool {
+	for _, wd := range r.ByWeekday {
+		if wd == w {
+			return true
+		}
+	}
+	return false
+}
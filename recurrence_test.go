@@ -0,0 +1,92 @@
+package datetime_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/maxbolgarin/datetime"
+)
+
+func TestRecurrenceOccurrences(t *testing.T) {
+	cases := []struct {
+		id       string
+		r        datetime.Recurrence
+		start    datetime.Date
+		count    int
+		expected []string
+	}{
+		{
+			id:       "daily",
+			r:        datetime.Recurrence{Freq: datetime.FreqDaily, Interval: 3},
+			start:    datetime.NewDate(2023, 4, 28),
+			count:    3,
+			expected: []string{"2023-04-28", "2023-05-01", "2023-05-04"},
+		},
+		{
+			id:       "weekly",
+			r:        datetime.Recurrence{Freq: datetime.FreqWeekly},
+			start:    datetime.NewDate(2023, 4, 15),
+			count:    3,
+			expected: []string{"2023-04-15", "2023-04-22", "2023-04-29"},
+		},
+		{
+			id:       "every_2_weeks_mon_wed",
+			r:        datetime.Recurrence{Freq: datetime.FreqWeekly, Interval: 2, ByWeekday: []time.Weekday{time.Wednesday, time.Monday}},
+			start:    datetime.NewDate(2023, 4, 3),
+			count:    5,
+			expected: []string{"2023-04-03", "2023-04-05", "2023-04-17", "2023-04-19", "2023-05-01"},
+		},
+		{
+			id:       "every_2_weeks_start_mid_week",
+			r:        datetime.Recurrence{Freq: datetime.FreqWeekly, Interval: 2, ByWeekday: []time.Weekday{time.Monday, time.Wednesday}},
+			start:    datetime.NewDate(2023, 4, 4),
+			count:    3,
+			expected: []string{"2023-04-05", "2023-04-17", "2023-04-19"},
+		},
+		{
+			id:       "monthly",
+			r:        datetime.Recurrence{Freq: datetime.FreqMonthly},
+			start:    datetime.NewDate(2023, 1, 31),
+			count:    4,
+			expected: []string{"2023-01-31", "2023-02-28", "2023-03-31", "2023-04-30"},
+		},
+		{
+			id:       "every_5_months",
+			r:        datetime.Recurrence{Freq: datetime.FreqMonthly, Interval: 5},
+			start:    datetime.NewDate(2023, 10, 15),
+			count:    3,
+			expected: []string{"2023-10-15", "2024-03-15", "2024-08-15"},
+		},
+		{
+			id:    "unknown_freq",
+			r:     datetime.Recurrence{Freq: "YEARLY"},
+			start: datetime.NewDate(2023, 4, 15),
+			count: 3,
+		},
+		{
+			id:    "invalid_weekday",
+			r:     datetime.Recurrence{Freq: datetime.FreqWeekly, Interval: 1, ByWeekday: []time.Weekday{7}},
+			start: datetime.NewDate(2023, 4, 15),
+			count: 3,
+		},
+		{
+			id:    "negative_weekday",
+			r:     datetime.Recurrence{Freq: datetime.FreqWeekly, ByWeekday: []time.Weekday{time.Monday, -1}},
+			start: datetime.NewDate(2023, 4, 15),
+			count: 3,
+		},
+		{
+			id:    "zero_count",
+			r:     datetime.Recurrence{Freq: datetime.FreqDaily},
+			start: datetime.NewDate(2023, 4, 15),
+		},
+	}
+
+	for _, c := range cases {
+		result := datetime.TransformDatesToString(c.r.Occurrences(c.start, c.count))
+		if strings.Join(result, ",") != strings.Join(c.expected, ",") {
+			t.Errorf("%s -> Occurrences() = %v; want %v", c.id, result, c.expected)
+		}
+	}
+}
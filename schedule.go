@@ -0,0 +1,79 @@
+package datetime
+
+import "time"
+
+// WeeklySchedule is a set of open intervals for every day of week.
+// Interval that crosses midnight belongs to the day when it starts.
+type WeeklySchedule map[time.Weekday][]Interval
+
+// NewWeeklySchedule returns new empty weekly schedule.
+func NewWeeklySchedule() WeeklySchedule {
+	return make(WeeklySchedule)
+}
+
+// Set sets open intervals for the weekday replacing the previous ones.
+func (s WeeklySchedule) Set(weekday time.Weekday, intervals ...Interval) {
+	s[weekday] = intervals
+}
+
+// TimeUntilClose returns duration until the end of the current open interval and true if it is open now.
+// It returns zero and false if schedule is closed at provided date and time.
+func (s WeeklySchedule) TimeUntilClose(d Date, t Time) (time.Duration, bool) {
+	for _, iv := range s[d.Weekday()] {
+		if iv.Contains(t) && t.IsAfter(iv.Start) {
+			return t.SmartDiff(iv.End), true
+		}
+	}
+	for _, iv := range s[d.PrevDay().Weekday()] {
+		if iv.IsWrapping() && t.IsBeforeStrict(iv.End) {
+			return t.SmartDiff(iv.End), true
+		}
+	}
+	return 0, false
+}
+
+// Merge returns new schedule where days set in other schedule replace days of the receiver.
+// Days that are not set in other schedule are preserved.
+func (s WeeklySchedule) Merge(other WeeklySchedule) WeeklySchedule {
+	out := make(WeeklySchedule, len(s)+len(other))
+	for weekday, intervals := range s {
+		out[weekday] = intervals
+	}
+	for weekday, intervals := range other {
+		out[weekday] = intervals
+	}
+	return out
+}
+
+// Clear removes intervals of the weekday, so it is not set in schedule anymore.
+func (s WeeklySchedule) Clear(weekday time.Weekday) {
+	delete(s, weekday)
+}
+
+// WorkingDuration returns total duration of open intervals of schedule between start and end.
+// Intervals of the same day are expected not to overlap. It returns 0 if end is before start.
+func (s WeeklySchedule) WorkingDuration(start, end DateTime) time.Duration {
+	from, to := start.minutes(), end.minutes()
+	if to <= from {
+		return 0
+	}
+
+	var total int64
+	for d := start.Date.PrevDay(); !d.After(end.Date.Time); d = d.NextDay() {
+		dayStart := NewDateTime(d, EmptyTime).minutes()
+		for _, iv := range s[d.Weekday()] {
+			ivStart := dayStart + int64(iv.Start.Key())
+			ivEnd := ivStart + int64(iv.Duration()/time.Minute)
+			if ivStart < from {
+				ivStart = from
+			}
+			if ivEnd > to {
+				ivEnd = to
+			}
+			if ivStart < ivEnd {
+				total += ivEnd - ivStart
+			}
+		}
+	}
+	return time.Duration(total) * time.Minute
+}
@@ -0,0 +1,141 @@
+package datetime_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maxbolgarin/datetime"
+)
+
+func TestWeeklyScheduleTimeUntilClose(t *testing.T) {
+	s := datetime.NewWeeklySchedule()
+	s.Set(time.Saturday, datetime.NewInterval(datetime.NewTime(9, 0), datetime.NewTime(18, 0)))
+	s.Set(time.Friday, datetime.NewInterval(datetime.NewTime(20, 0), datetime.NewTime(2, 0)))
+
+	var (
+		friday   = datetime.NewDate(2023, 4, 14)
+		saturday = datetime.NewDate(2023, 4, 15)
+		sunday   = datetime.NewDate(2023, 4, 16)
+	)
+
+	cases := []struct {
+		id       string
+		date     datetime.Date
+		time     datetime.Time
+		expected time.Duration
+		isOpen   bool
+	}{
+		{"inside", saturday, datetime.NewTime(17, 40), 20 * time.Minute, true},
+		{"start", saturday, datetime.NewTime(9, 0), 9 * time.Hour, true},
+		{"close", saturday, datetime.NewTime(18, 0), 0, false},
+		{"closed", saturday, datetime.NewTime(20, 0), 0, false},
+		{"closed_day", sunday, datetime.NewTime(12, 0), 0, false},
+		{"before_midnight", friday, datetime.NewTime(23, 0), 3 * time.Hour, true},
+		{"after_midnight", saturday, datetime.NewTime(1, 30), 30 * time.Minute, true},
+		{"after_midnight_close", saturday, datetime.NewTime(2, 0), 0, false},
+		{"wrap_wrong_day", friday, datetime.NewTime(1, 0), 0, false},
+	}
+
+	for _, c := range cases {
+		d, isOpen := s.TimeUntilClose(c.date, c.time)
+		if d != c.expected || isOpen != c.isOpen {
+			t.Errorf("%s -> TimeUntilClose() = %v, %v; want %v, %v", c.id, d, isOpen, c.expected, c.isOpen)
+		}
+	}
+}
+
+func TestWeeklyScheduleMerge(t *testing.T) {
+	var (
+		workDay  = datetime.NewInterval(datetime.NewTime(9, 0), datetime.NewTime(18, 0))
+		shortDay = datetime.NewInterval(datetime.NewTime(10, 0), datetime.NewTime(14, 0))
+	)
+
+	base := datetime.NewWeeklySchedule()
+	for _, weekday := range []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday} {
+		base.Set(weekday, workDay)
+	}
+
+	override := datetime.NewWeeklySchedule()
+	override.Set(time.Friday, shortDay)
+	override.Set(time.Saturday, shortDay)
+	override.Set(time.Monday)
+
+	merged := base.Merge(override)
+
+	if len(merged[time.Friday]) != 1 || merged[time.Friday][0] != shortDay {
+		t.Errorf("Merge() Friday = %v; want %v", merged[time.Friday], shortDay)
+	}
+	if len(merged[time.Saturday]) != 1 || merged[time.Saturday][0] != shortDay {
+		t.Errorf("Merge() Saturday = %v; want %v", merged[time.Saturday], shortDay)
+	}
+	if intervals, ok := merged[time.Monday]; !ok || len(intervals) != 0 {
+		t.Errorf("Merge() Monday = %v; want set and empty", intervals)
+	}
+	if len(merged[time.Tuesday]) != 1 || merged[time.Tuesday][0] != workDay {
+		t.Errorf("Merge() Tuesday = %v; want %v", merged[time.Tuesday], workDay)
+	}
+	if _, ok := merged[time.Sunday]; ok {
+		t.Error("Merge() Sunday should not be set")
+	}
+	if base[time.Friday][0] != workDay {
+		t.Error("Merge() should not modify the receiver")
+	}
+
+	merged.Clear(time.Saturday)
+	if _, ok := merged[time.Saturday]; ok {
+		t.Error("Clear() should remove Saturday")
+	}
+	if _, isOpen := merged.TimeUntilClose(datetime.NewDate(2023, 4, 15), datetime.NewTime(12, 0)); isOpen {
+		t.Error("TimeUntilClose() should return closed for cleared day")
+	}
+}
+
+func TestWeeklyScheduleWorkingDuration(t *testing.T) {
+	s := datetime.NewWeeklySchedule()
+	for _, weekday := range []time.Weekday{time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday} {
+		s.Set(weekday, datetime.NewInterval(datetime.NewTime(9, 0), datetime.NewTime(17, 0)))
+	}
+
+	dt := func(month, day, hour, minute int) datetime.DateTime {
+		return datetime.NewDateTime(datetime.NewDate(2023, month, day), datetime.NewTime(hour, minute))
+	}
+
+	// 2023-04-14 is Friday, 2023-04-17 is Monday.
+	cases := []struct {
+		id         string
+		start, end datetime.DateTime
+		expected   time.Duration
+	}{
+		{"within_day", dt(4, 14, 10, 0), dt(4, 14, 12, 30), 2*time.Hour + 30*time.Minute},
+		{"before_open", dt(4, 14, 7, 0), dt(4, 14, 10, 0), time.Hour},
+		{"whole_day", dt(4, 14, 0, 0), dt(4, 15, 0, 0), 8 * time.Hour},
+		{"across_weekend", dt(4, 14, 16, 0), dt(4, 17, 10, 0), 2 * time.Hour},
+		{"weekend_only", dt(4, 15, 9, 0), dt(4, 16, 17, 0), 0},
+		{"two_weeks", dt(4, 17, 9, 0), dt(5, 1, 9, 0), 80 * time.Hour},
+		{"reversed", dt(4, 14, 12, 0), dt(4, 14, 10, 0), 0},
+	}
+
+	for _, c := range cases {
+		if result := s.WorkingDuration(c.start, c.end); result != c.expected {
+			t.Errorf("%s -> WorkingDuration() = %v; want %v", c.id, result, c.expected)
+		}
+	}
+
+	night := datetime.NewWeeklySchedule()
+	night.Set(time.Friday, datetime.NewInterval(datetime.NewTime(22, 0), datetime.NewTime(2, 0)))
+	if result := night.WorkingDuration(dt(4, 15, 0, 0), dt(4, 15, 12, 0)); result != 2*time.Hour {
+		t.Errorf("WorkingDuration(after midnight) = %v; want %v", result, 2*time.Hour)
+	}
+
+	full := datetime.NewWeeklySchedule()
+	full.Set(time.Saturday, datetime.MergeIntervals([]datetime.Interval{
+		datetime.NewInterval(datetime.NewTime(0, 0), datetime.NewTime(12, 0)),
+		datetime.NewInterval(datetime.NewTime(12, 0), datetime.NewTime(0, 0)),
+	})...)
+	if result := full.WorkingDuration(dt(4, 15, 0, 0), dt(4, 17, 0, 0)); result != 24*time.Hour {
+		t.Errorf("WorkingDuration(full day) = %v; want %v", result, 24*time.Hour)
+	}
+	if _, isOpen := full.TimeUntilClose(datetime.NewDate(2023, 4, 15), datetime.NewTime(6, 0)); !isOpen {
+		t.Error("TimeUntilClose() should return open for full day")
+	}
+}
@@ -1,9 +1,12 @@
 package datetime
 
 import (
+	"encoding/binary"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -29,6 +32,22 @@ func NewTime(hour, minute int) Time {
 	return Time{time.Date(0, 0, 0, hour, minute, 0, 0, time.UTC), true}
 }
 
+// NewTimeNormalized returns new time from hour and minute wrapping overflow within a day,
+// e.g. minute=90 adds an hour and 30 minutes, hour=25 is 01:00 and minute=-1 is 23:59.
+func NewTimeNormalized(hour, minute int) Time {
+	total := (hour*60 + minute) % minutesInDay
+	if total < 0 {
+		total += minutesInDay
+	}
+	return NewTime(total/60, total%60)
+}
+
+// TimeAt returns time that is offset after base, wrapping across midnight.
+// It is the same as base.AddTime(offset) for positive offsets, negative offset moves time back.
+func TimeAt(base Time, offset time.Duration) Time {
+	return shiftTime(base, offset)
+}
+
 // NewTimeFromString returns new time from HH:MM string.
 func NewTimeFromString(s string) (Time, error) {
 	d, err := time.Parse(timeLayout, s)
@@ -49,76 +68,473 @@ func NowTime(tz *time.Location) Time {
 	return NewFromTime(now)
 }
 
+// TimeFromAny returns new Time from one of the supported types:
+// string (parsed with ParseTime), time.Duration since midnight, int minutes since midnight, time.Time or Time.
+func TimeFromAny(v interface{}) (Time, error) {
+	switch val := v.(type) {
+	case string:
+		return ParseTime(val)
+	case time.Duration:
+		if val < 0 || val >= 24*time.Hour {
+			return Time{}, fmt.Errorf("invalid duration=%s", val)
+		}
+		return newTimeFromMinutes(int(val / time.Minute))
+	case int:
+		return newTimeFromMinutes(val)
+	case time.Time:
+		return NewFromTime(val), nil
+	case Time:
+		return val, nil
+	default:
+		return Time{}, fmt.Errorf("unsupported type %T", v)
+	}
+}
+
+func newTimeFromMinutes(minutes int) (Time, error) {
+	if minutes < 0 || minutes >= minutesInDay {
+		return Time{}, fmt.Errorf("invalid minutes=%d", minutes)
+	}
+	return NewTime(minutes/60, minutes%60), nil
+}
+
 // ParseTime tries to parse time (HH:MM) using separators: [" ", ":", "-", "_", ",", "."].
+// It also accepts 12-hour format with am/pm suffix, e.g. "9:30 pm" or "9am".
 func ParseTime(s string) (Time, error) {
+	t, _, err := ParseTimeDetailed(s)
+	return t, err
+}
+
+// ParseTimeStrict parses time only in H:MM or HH:MM format, e.g. "9:05" or "10:30".
+// Unlike ParseTime, it rejects other separators, compact "1030" and 12-hour forms.
+func ParseTimeStrict(s string) (Time, error) {
+	t, ok, err := parseTimeCanonical(s)
+	if !ok {
+		return Time{}, fmt.Errorf("invalid time=%s", s)
+	}
+	return t, err
+}
+
+// ParseDecimalHour parses time from decimal hours, e.g. "9.5" is 09:30 and "9.25" is 09:15.
+// Result is rounded to the nearest minute, hours should be in range [0, 24).
+func ParseDecimalHour(s string) (Time, error) {
+	n := strings.TrimSpace(s)
+	if n == "" || prepareNumber(n, true) != n {
+		return Time{}, fmt.Errorf("invalid decimal hour=%s", s)
+	}
+	hours, err := strconv.ParseFloat(n, 64)
+	if err != nil {
+		return Time{}, fmt.Errorf("parse hour=%s: %w", n, err)
+	}
+	t, err := newTimeFromMinutes(int(math.Round(hours * 60)))
+	if err != nil {
+		return Time{}, fmt.Errorf("invalid decimal hour=%s", s)
+	}
+	return t, nil
+}
+
+// ParseTimeWithZone parses time with optional trailing timezone separated by space, e.g. "10:30 UTC+3"
+// or "10:30 Europe/Moscow". Time is parsed by ParseTime and timezone by ParseTimezone.
+// If there is no timezone in input, zero Timezone is returned and time should be treated as UTC.
+func ParseTimeWithZone(s string) (Time, Timezone, error) {
+	s = strings.TrimSpace(s)
+	idx := strings.LastIndexByte(s, ' ')
+	if idx < 0 {
+		t, err := ParseTime(s)
+		return t, Timezone{}, err
+	}
+
+	tz, zoneErr := ParseTimezone(s[idx+1:])
+	if zoneErr == nil {
+		t, err := ParseTime(strings.TrimSpace(s[:idx]))
+		if err != nil {
+			return Time{}, Timezone{}, err
+		}
+		return t, tz, nil
+	}
+
+	// Last token may be a part of time, e.g. "10 30" or "10:30 pm".
+	t, err := ParseTime(s)
+	if err != nil {
+		return Time{}, Timezone{}, fmt.Errorf("parse timezone=%s: %w", s[idx+1:], zoneErr)
+	}
+	return t, Timezone{}, nil
+}
+
+// Formats of time returned by ParseTimeDetailed.
+const (
+	TimeFormatSpace      = "space"
+	TimeFormatColon      = "colon"
+	TimeFormatDash       = "dash"
+	TimeFormatUnderscore = "underscore"
+	TimeFormatComma      = "comma"
+	TimeFormatDot        = "dot"
+	TimeFormatCompact    = "compact"
+	TimeFormat12Hour     = "12-hour"
+)
+
+var timeSeparators = []struct {
+	sep    string
+	format string
+}{
+	{" ", TimeFormatSpace},
+	{":", TimeFormatColon},
+	{"-", TimeFormatDash},
+	{"_", TimeFormatUnderscore},
+	{",", TimeFormatComma},
+	{".", TimeFormatDot},
+}
+
+// ParseTimeDetailed parses time like ParseTime and returns format of the input:
+// a name of separator (e.g. "colon" for "10:30"), "compact" for "1030" or "12-hour" for "10:30 am".
+func ParseTimeDetailed(s string) (Time, string, error) {
 	if s == "" {
-		return Time{}, errors.New("time is empty")
+		return Time{}, "", errors.New("time is empty")
 	}
-	seps := []string{" ", ":", "-", "_", ",", "."}
-	for _, sep := range seps {
-		splitted := strings.Split(s, sep)
+	if t, ok, err := parseTimeCanonical(s); ok {
+		return t, TimeFormatColon, err
+	}
+	if t, ok, err := parseTime12(s); ok {
+		return t, TimeFormat12Hour, err
+	}
+	for _, ts := range timeSeparators {
+		format := ts.format
+		splitted := strings.Split(s, ts.sep)
 		if len(splitted) != 2 {
 			if len(s) != 4 {
 				continue
 			}
 			splitted = []string{string(s[0:2]), string(s[2:4])}
+			format = TimeFormatCompact
 		}
 
 		splitted[0] = prepareNumber(splitted[0], false)
 		hour, err := strconv.Atoi(splitted[0])
 		if err != nil {
-			return Time{}, fmt.Errorf("parse hour=%s: %w", splitted[0], err)
+			return Time{}, "", fmt.Errorf("parse hour=%s: %w", splitted[0], err)
 		}
 		if hour < 0 || hour > 23 {
-			return Time{}, fmt.Errorf("invalid hour=%d", hour)
+			return Time{}, "", fmt.Errorf("invalid hour=%d", hour)
 		}
 
 		splitted[1] = prepareNumber(splitted[1], false)
 		minute, err := strconv.Atoi(splitted[1])
 		if err != nil {
-			return Time{}, fmt.Errorf("parse minute=%s: %w", splitted[1], err)
+			return Time{}, "", fmt.Errorf("parse minute=%s: %w", splitted[1], err)
 		}
 		if minute < 0 || minute > 59 {
-			return Time{}, fmt.Errorf("invalid minute=%d", minute)
+			return Time{}, "", fmt.Errorf("invalid minute=%d", minute)
+		}
+
+		return NewTime(hour, minute), format, nil
+	}
+
+	return Time{}, "", fmt.Errorf("invalid time=%s", s)
+}
+
+// AllTimesOfDay returns all times of the day from 00:00 with provided step.
+// Step should be a whole number of minutes dividing the day without remainder, otherwise nil is returned.
+func AllTimesOfDay(step time.Duration) []Time {
+	if step < time.Minute || step%time.Minute != 0 {
+		return nil
+	}
+	stepMinutes := int(step / time.Minute)
+	if minutesInDay%stepMinutes != 0 {
+		return nil
+	}
+	out := make([]Time, 0, minutesInDay/stepMinutes)
+	for m := 0; m < minutesInDay; m += stepMinutes {
+		out = append(out, NewTime(m/60, m%60))
+	}
+	return out
+}
+
+// parseTimeCanonical parses time in H:MM or HH:MM format without allocations,
+// it returns false if input is not in this format.
+func parseTimeCanonical(s string) (Time, bool, error) {
+	var hour int
+	switch {
+	case len(s) == 5 && s[2] == ':' && isDigit(s[0]) && isDigit(s[1]):
+		hour = int(s[0]-'0')*10 + int(s[1]-'0')
+	case len(s) == 4 && s[1] == ':' && isDigit(s[0]):
+		hour = int(s[0] - '0')
+	default:
+		return Time{}, false, nil
+	}
+	m := s[len(s)-2:]
+	if !isDigit(m[0]) || !isDigit(m[1]) {
+		return Time{}, false, nil
+	}
+	minute := int(m[0]-'0')*10 + int(m[1]-'0')
+
+	if hour > 23 {
+		return Time{}, true, fmt.Errorf("invalid hour=%d", hour)
+	}
+	if minute > 59 {
+		return Time{}, true, fmt.Errorf("invalid minute=%d", minute)
+	}
+	return NewTime(hour, minute), true, nil
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+// parseTime12 parses time in 12-hour format with am/pm suffix, it returns false if there is no suffix.
+// Bare hour like "9am" is treated as "9:00 am", 12am is midnight and 12pm is noon.
+func parseTime12(s string) (Time, bool, error) {
+	lower := strings.ToLower(strings.TrimSpace(s))
+	isPM := strings.HasSuffix(lower, "pm")
+	if !isPM && !strings.HasSuffix(lower, "am") {
+		return Time{}, false, nil
+	}
+	rest := strings.TrimSpace(lower[:len(lower)-2])
+
+	var hour, minute int
+	if len(rest) <= 2 {
+		h, err := strconv.Atoi(rest)
+		if err != nil {
+			return Time{}, true, fmt.Errorf("parse hour=%s: %w", rest, err)
+		}
+		hour = h
+	} else {
+		t, err := ParseTime(rest)
+		if err != nil {
+			return Time{}, true, err
 		}
+		hour, minute = t.Hour(), t.Minute()
+	}
+	if hour < 1 || hour > 12 {
+		return Time{}, true, fmt.Errorf("invalid 12-hour hour=%d", hour)
+	}
 
-		return NewTime(hour, minute), nil
+	hour %= 12
+	if isPM {
+		hour += 12
 	}
+	return NewTime(hour, minute), true, nil
+}
 
-	return Time{}, fmt.Errorf("invalid time=%s", s)
+// FilterTimesBetween returns times that are between start and end including both ends.
+// If end is before start, interval crosses midnight.
+func FilterTimesBetween(times []Time, start, end Time) []Time {
+	out := make([]Time, 0, len(times))
+	for _, t := range times {
+		if t.Between(start, end) {
+			out = append(out, t)
+		}
+	}
+	return out
 }
 
+// FilterTimesAfter returns times that are after or equal to threshold.
+func FilterTimesAfter(times []Time, threshold Time) []Time {
+	out := make([]Time, 0, len(times))
+	for _, t := range times {
+		if t.IsAfter(threshold) {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// SortTimes sorts times, not initialized times are sorted as 00:00.
+func SortTimes(times []Time, desc bool) {
+	sort.Slice(times, func(i, j int) bool {
+		if desc {
+			return times[i].Key() > times[j].Key()
+		}
+		return times[i].Key() < times[j].Key()
+	})
+}
+
+// SortTimesUnsetLast sorts times like SortTimes, but not initialized times are placed at the end
+// regardless of direction.
+func SortTimesUnsetLast(times []Time, desc bool) {
+	sort.Slice(times, func(i, j int) bool {
+		if times[i].isSet != times[j].isSet {
+			return times[i].isSet
+		}
+		if desc {
+			return times[i].Key() > times[j].Key()
+		}
+		return times[i].Key() < times[j].Key()
+	})
+}
+
+// TimesFromTimes returns times of day of provided time.Time values using NewFromTime.
+func TimesFromTimes(ts []time.Time) []Time {
+	out := make([]Time, 0, len(ts))
+	for _, t := range ts {
+		out = append(out, NewFromTime(t))
+	}
+	return out
+}
+
+// UniqueTimes returns times without duplicates preserving the order of first occurrence.
+// Not initialized times are considered different from 00:00, only the first of them is kept.
+func UniqueTimes(times []Time) []Time {
+	seen := make(map[int]struct{}, len(times))
+	out := make([]Time, 0, len(times))
+	for _, t := range times {
+		key := t.Key()
+		if !t.isSet {
+			key = -1
+		}
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+		out = append(out, t)
+	}
+	return out
+}
+
+// NextOccurrence returns the soonest instant at or after now that has one of the daily times in provided timezone.
+// It is one of the remaining times of today or the earliest time of tomorrow.
+// It returns zero time.Time if times are empty.
+func NextOccurrence(times []Time, now time.Time, tz *time.Location) time.Time {
+	if tz == nil {
+		tz = time.UTC
+	}
+	now = now.In(tz)
+
+	var out time.Time
+	for _, dayShift := range []int{0, 1} {
+		for _, t := range times {
+			candidate := time.Date(now.Year(), now.Month(), now.Day()+dayShift, t.Hour(), t.Minute(), 0, 0, tz)
+			if candidate.Before(now) {
+				continue
+			}
+			if out.IsZero() || candidate.Before(out) {
+				out = candidate
+			}
+		}
+		if !out.IsZero() {
+			break
+		}
+	}
+	return out
+}
+
+// timeStrings contains all times of the day in HH:MM format one after another,
+// so String returns a substring of it without allocation.
+var timeStrings = func() string {
+	buf := make([]byte, 0, minutesInDay*len(timeLayout))
+	for hour := 0; hour < 24; hour++ {
+		for minute := 0; minute < 60; minute++ {
+			buf = append(buf, byte('0'+hour/10), byte('0'+hour%10), ':', byte('0'+minute/10), byte('0'+minute%10))
+		}
+	}
+	return string(buf)
+}()
+
 // String returns time in HH:MM format.
 func (t Time) String() string {
-	return t.Format(timeLayout)
+	i := t.Key() * len(timeLayout)
+	return timeStrings[i : i+len(timeLayout)]
+}
+
+// AppendFormat appends time in HH:MM format to b and returns the extended buffer.
+// It doesn't allocate if b has enough capacity.
+func (t Time) AppendFormat(b []byte) []byte {
+	return append(b, t.String()...)
 }
 
 // Range substracts low from high time and returns duration between it.
 func (low Time) Range(high Time) time.Duration {
-	return time.Hour*time.Duration(high.Hour()-low.Hour()) +
-		time.Minute*time.Duration(high.Minute()-low.Minute())
+	return low.Diff(high, SignedDiff)
 }
 
 // RangeUp returns duration from low time to high time ignoring dates.
 func (low Time) RangeUp(high Time) time.Duration {
-	var hours, minutes int
-	if high.Hour() < low.Hour() {
-		hours = 24 - low.Hour() + high.Hour()
-	} else {
-		hours = high.Hour() - low.Hour()
+	return low.Diff(high, ForwardDiff)
+}
+
+// StringWith returns time in HH<sep>MM format, both hour and minute are zero-padded to two digits.
+func (t Time) StringWith(sep string) string {
+	return fmt.Sprintf("%02d%s%02d", t.Hour(), sep, t.Minute())
+}
+
+// FormatDuration returns duration in (-)HH:MM format, e.g. "-01:30" for -90 minutes.
+// Sign is placed before the whole value, hours are not limited to 24, seconds are truncated.
+func FormatDuration(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign = "-"
+		d = -d
+	}
+	minutes := int(d / time.Minute)
+	if minutes == 0 {
+		sign = ""
+	}
+	return fmt.Sprintf("%s%02d:%02d", sign, minutes/60, minutes%60)
+}
+
+// isoDurationUnits contains lengths of units supported by ParseISODuration.
+var isoDurationUnits = map[byte]time.Duration{
+	'D': 24 * time.Hour,
+	'H': time.Hour,
+	'M': time.Minute,
+	'S': time.Second,
+}
+
+// ParseISODuration parses ISO 8601 duration in P[nD][T[nH][nM][nS]] format, e.g. "PT1H30M" or "P2D".
+// Day is considered to be 24 hours, years, months and weeks are not supported because their length is ambiguous.
+// Values may have a decimal fraction, e.g. "PT1.5S", and the whole duration may be negative with leading "-".
+func ParseISODuration(s string) (time.Duration, error) {
+	rest := s
+	sign := 1.0
+	if strings.HasPrefix(rest, "-") {
+		sign = -1
+		rest = rest[1:]
+	}
+	if len(rest) < 2 || rest[0] != 'P' {
+		return 0, fmt.Errorf("invalid duration=%s", s)
 	}
+	rest = rest[1:]
 
-	if high.Minute() < low.Minute() {
-		hours -= 1
-		if hours < 0 {
-			hours = 23
+	var (
+		total   float64
+		allowed = "D"
+		inTime  bool
+	)
+	for rest != "" {
+		if rest[0] == 'T' {
+			if inTime || len(rest) == 1 {
+				return 0, fmt.Errorf("invalid duration=%s", s)
+			}
+			inTime, allowed, rest = true, "HMS", rest[1:]
+			continue
 		}
-		minutes = 60 - low.Minute() + high.Minute()
-	} else {
-		minutes = high.Minute() - low.Minute()
+		i := 0
+		for i < len(rest) && (isDigit(rest[i]) || rest[i] == '.') {
+			i++
+		}
+		if i == 0 || i == len(rest) {
+			return 0, fmt.Errorf("invalid duration=%s", s)
+		}
+		// Units should go in order and only once, so the rest of allowed units are after the current one.
+		unit := rest[i]
+		idx := strings.IndexByte(allowed, unit)
+		if idx < 0 {
+			return 0, fmt.Errorf("unsupported unit=%c in duration=%s", unit, s)
+		}
+		allowed = allowed[idx+1:]
+
+		v, err := strconv.ParseFloat(rest[:i], 64)
+		if err != nil {
+			return 0, fmt.Errorf("parse value=%s: %w", rest[:i], err)
+		}
+		total += v * float64(isoDurationUnits[unit])
+		rest = rest[i+1:]
 	}
 
-	return time.Hour*time.Duration(hours) + time.Minute*time.Duration(minutes)
+	total = math.Round(sign * total)
+	// float64(math.MaxInt64) is rounded up to 2^63, which is already out of range.
+	if total < math.MinInt64 || total >= math.MaxInt64 {
+		return 0, fmt.Errorf("duration=%s is out of range", s)
+	}
+	return time.Duration(total), nil
 }
 
 // AddTime adds howMuch to time.
@@ -157,6 +573,30 @@ func (t Time) subTime(what time.Duration, howMuch int) Time {
 	return t.addTime(what, -1*howMuch)
 }
 
+// ShiftByOffset shifts time by signed offset in seconds, e.g. the result of Timezone.Offset(),
+// and returns shifted time with number of days it has rolled over: 1 if it passed midnight forward,
+// -1 if backward and 0 if it stays within the same day. Seconds that don't form a full minute are dropped.
+func (t Time) ShiftByOffset(offsetSeconds int) (Time, int) {
+	total := t.Key() + offsetSeconds/60
+	dayShift := total / minutesInDay
+	total %= minutesInDay
+	if total < 0 {
+		total += minutesInDay
+		dayShift--
+	}
+	return NewTime(total/60, total%60), dayShift
+}
+
+// LogicalDayOffset returns 0 if time belongs to the same logical day that starts at dayStart,
+// i.e. it is at or after dayStart, and 1 if it is before dayStart, so it belongs to the logical day
+// that started on the previous calendar date. E.g. with dayStart 04:00 time 03:59 has offset 1.
+func (t Time) LogicalDayOffset(dayStart Time) int {
+	if t.IsBeforeStrict(dayStart) {
+		return 1
+	}
+	return 0
+}
+
 // MinutesFromDayBegin returns number of minutes passed from the beginning of the day.
 func (t Time) MinutesFromDayBegin(dayStartTime Time) int {
 	var hours int
@@ -168,6 +608,12 @@ func (t Time) MinutesFromDayBegin(dayStartTime Time) int {
 	return hours*60 + t.Minute()
 }
 
+// Key returns number of minutes since midnight, it is a stable collision-free key for use in maps.
+// It is identical to MinutesFromDayBegin(EmptyTime). Note that EmptyTime has the same key as 00:00.
+func (t Time) Key() int {
+	return t.Hour()*60 + t.Minute()
+}
+
 // MinutesTillDayEnd returns number of minutes remaining to the end of the day.
 func (t Time) MinutesTillDayEnd(dayStartTime Time) int {
 	return minutesInDay - t.MinutesFromDayBegin(dayStartTime)
@@ -242,17 +688,123 @@ func (t Time) IsArgAfterStrict(other Time) bool {
 	return t.IsBeforeStrict(other)
 }
 
+// Between returns true if reciever is between start and end, both ends are included.
+// If end is before start, interval crosses midnight.
+func (t Time) Between(start, end Time) bool {
+	if end.IsBeforeStrict(start) {
+		return t.IsAfter(start) || t.IsBefore(end)
+	}
+	return t.IsAfter(start) && t.IsBefore(end)
+}
+
+// InOpenInterval returns true if reciever is STRICTLY between start and end, both ends are excluded.
+// If end is before start, interval crosses midnight.
+func (t Time) InOpenInterval(start, end Time) bool {
+	if end.IsBeforeStrict(start) {
+		return t.IsAfterStrict(start) || t.IsBeforeStrict(end)
+	}
+	return t.IsAfterStrict(start) && t.IsBeforeStrict(end)
+}
+
+// DiffMode is a way to calculate difference between two times of day.
+type DiffMode int
+
+const (
+	// SignedDiff is a difference within the same day, it is negative if end is before start.
+	SignedDiff DiffMode = iota
+	// ForwardDiff is a difference moving forward from start to end, crossing midnight if end is before start.
+	ForwardDiff
+	// ShortestDiff is the shortest difference moving forward or backward from start to end, crossing
+	// midnight if needed. It is negative if moving backward is shorter, 12h difference is positive.
+	ShortestDiff
+)
+
+// Diff returns difference between reciever as start and argument as end calculated with provided mode.
+// Range, RangeUp and SmartDiff are the same as Diff with SignedDiff, ForwardDiff and ForwardDiff modes.
+func (start Time) Diff(end Time, mode DiffMode) time.Duration {
+	diff := end.Key() - start.Key()
+	if mode == SignedDiff {
+		return time.Duration(diff) * time.Minute
+	}
+	if diff < 0 {
+		diff += minutesInDay
+	}
+	if mode == ShortestDiff && diff > minutesInDay/2 {
+		diff -= minutesInDay
+	}
+	return time.Duration(diff) * time.Minute
+}
+
 // SmartDiff returns diff where reciever is start and argument is end
 func (start Time) SmartDiff(end Time) time.Duration {
-	var (
-		startMinutes = start.MinutesFromDayBegin(EmptyTime)
-		endMinutes   = end.MinutesFromDayBegin(EmptyTime)
-	)
+	return start.Diff(end, ForwardDiff)
+}
+
+// SmartDiffNonZero returns diff like SmartDiff, but equal times are considered to be a full day apart,
+// so it returns 24h instead of 0. It is useful for gaps between occurrences of recurring events.
+func (start Time) SmartDiffNonZero(end Time) time.Duration {
+	if diff := start.SmartDiff(end); diff != 0 {
+		return diff
+	}
+	return minutesInDay * time.Minute
+}
+
+// DiffComponents returns SmartDiff between reciever and argument split into hours and minutes.
+func (start Time) DiffComponents(end Time) (hours, minutes int) {
+	total := int(start.SmartDiff(end) / time.Minute)
+	return total / 60, total % 60
+}
+
+// Midpoint returns time in the middle between reciever and argument, where reciever is start
+// and argument is end like in SmartDiff, e.g. midpoint of 22:00 and 02:00 is 00:00.
+// If span has odd number of minutes, the earlier of two middle minutes is returned.
+func (start Time) Midpoint(end Time) Time {
+	half := int(start.SmartDiff(end)/time.Minute) / 2
+	return NewTimeNormalized(0, start.Key()+half)
+}
+
+// ClockAngles returns angles of hour and minute hands of analog clock in degrees,
+// clockwise from 12 o'clock. Hour hand moves with minutes, e.g. 01:30 is (45, 180).
+func (t Time) ClockAngles() (hourDeg, minuteDeg float64) {
+	hourDeg = float64(t.Hour()%12)*30 + float64(t.Minute())*0.5
+	minuteDeg = float64(t.Minute()) * 6
+	return hourDeg, minuteDeg
+}
 
-	if endMinutes >= startMinutes {
-		return time.Minute * time.Duration(endMinutes-startMinutes)
+// IsOnMinuteMultiple returns true if minute of time is a multiple of m, e.g. 10:15 is on 15 minutes multiple
+// and 10:07 is not. For m >= 60 whole time since midnight should be a multiple of m, e.g. 10:00 is on 120 minutes
+// multiple and 11:00 is not. It returns false if m is not positive.
+func (t Time) IsOnMinuteMultiple(m int) bool {
+	if m <= 0 {
+		return false
 	}
-	return time.Minute * time.Duration(endMinutes+start.MinutesTillDayEnd(EmptyTime))
+	if m < 60 {
+		return t.Minute()%m == 0
+	}
+	return t.Key()%m == 0
+}
+
+// EarliestFreeSlot returns the first time starting from reciever and moving forward by step,
+// which is at least gap away from every taken time in both directions, wrapping across midnight.
+// It returns false if there is no such time within a day or step is less than a minute.
+func (t Time) EarliestFreeSlot(taken []Time, gap, step time.Duration) (Time, bool) {
+	if step < time.Minute {
+		return Time{}, false
+	}
+	for offset := time.Duration(0); offset < minutesInDay*time.Minute; offset += step {
+		candidate := shiftTime(t, offset)
+		free := true
+		for _, tt := range taken {
+			if candidate.SmartDiff(tt) < gap || tt.SmartDiff(candidate) < gap {
+				free = false
+				break
+			}
+		}
+		if free {
+			return candidate, true
+		}
+	}
+	return Time{}, false
 }
 
 // RoundDownToFives returns time rounded to nearest 5 minutes
@@ -277,6 +829,52 @@ func (t Time) RoundUpToFives() Time {
 	return NewFromTime(t.RoundDownToFives().Add(5 * time.Minute))
 }
 
+// RoundDownToQuarter returns time rounded down to the quarter of hour, e.g. 10:07 -> 10:00.
+func (t Time) RoundDownToQuarter() Time {
+	return NewTime(t.Hour(), t.Minute()/15*15)
+}
+
+// RoundUpToQuarter returns time rounded up to the quarter of hour, e.g. 10:07 -> 10:15.
+// Time after 23:45 is rounded to 00:00.
+func (t Time) RoundUpToQuarter() Time {
+	if t.Minute()%15 == 0 {
+		return NewTime(t.Hour(), t.Minute())
+	}
+	return t.RoundDownToQuarter().AddTime(15 * time.Minute)
+}
+
+// RoundToQuarter returns time rounded to the nearest quarter of hour, e.g. 10:07 -> 10:00, 10:08 -> 10:15.
+func (t Time) RoundToQuarter() Time {
+	if t.Minute()%15 < 8 {
+		return t.RoundDownToQuarter()
+	}
+	return t.RoundUpToQuarter()
+}
+
+// SnapUp returns the nearest slot at or after the time, wrapping across midnight if needed.
+// It returns the time itself if slots are empty.
+func (t Time) SnapUp(slots []Time) Time {
+	out, best := t, time.Duration(-1)
+	for _, slot := range slots {
+		if d := t.SmartDiff(slot); best < 0 || d < best {
+			out, best = slot, d
+		}
+	}
+	return out
+}
+
+// SnapDown returns the nearest slot at or before the time, wrapping across midnight if needed.
+// It returns the time itself if slots are empty.
+func (t Time) SnapDown(slots []Time) Time {
+	out, best := t, time.Duration(-1)
+	for _, slot := range slots {
+		if d := slot.SmartDiff(t); best < 0 || d < best {
+			out, best = slot, d
+		}
+	}
+	return out
+}
+
 // IsZero returns true if time is empty.
 func (t Time) IsZero() bool {
 	if t.Time.IsZero() {
@@ -285,7 +883,21 @@ func (t Time) IsZero() bool {
 	return false
 }
 
+// IsSet returns true if time is explicitly set, e.g. created by NewTime or parsed.
+// It distinguishes midnight from not initialized Time.
+func (t Time) IsSet() bool {
+	return t.isSet
+}
+
+// WithSet returns copy of time with provided set state, hours and minutes are kept.
+// Time that is not set is marshaled to null.
+func (t Time) WithSet(isSet bool) Time {
+	t.isSet = isSet
+	return t
+}
+
 // MarshalJSON implements json.Marshaler interface to marshal Time to JSON.
+// Time has minute precision, so it is always marshaled as "HH:MM" string.
 func (t Time) MarshalJSON() ([]byte, error) {
 	if !t.isSet {
 		return []byte("null"), nil
@@ -294,7 +906,21 @@ func (t Time) MarshalJSON() ([]byte, error) {
 }
 
 // UnmarshalJSON implements json.Unmarshaler interface to unmarshal Time from JSON.
+// It accepts a string that can be parsed by ParseTime or a number of minutes since midnight.
 func (i *Time) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && (data[0] == '-' || (data[0] >= '0' && data[0] <= '9')) {
+		minutes, err := strconv.Atoi(string(data))
+		if err != nil {
+			return fmt.Errorf("parse minutes=%s: %w", data, err)
+		}
+		res, err := newTimeFromMinutes(minutes)
+		if err != nil {
+			return err
+		}
+		*i = res
+		return nil
+	}
+
 	var s string
 	if err := json.Unmarshal(data, &s); err != nil {
 		return err
@@ -312,6 +938,39 @@ func (i *Time) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// unsetTimeBinary is a binary representation of not initialized Time.
+const unsetTimeBinary = 0xFFFF
+
+// MarshalBinary implements encoding.BinaryMarshaler interface to marshal Time to 2 bytes
+// with number of minutes since midnight.
+func (t Time) MarshalBinary() ([]byte, error) {
+	out := make([]byte, 2)
+	if !t.isSet {
+		binary.BigEndian.PutUint16(out, unsetTimeBinary)
+		return out, nil
+	}
+	binary.BigEndian.PutUint16(out, uint16(t.Hour()*60+t.Minute()))
+	return out, nil
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler interface to unmarshal Time from 2 bytes.
+func (t *Time) UnmarshalBinary(data []byte) error {
+	if len(data) != 2 {
+		return fmt.Errorf("invalid data length=%d", len(data))
+	}
+	minutes := binary.BigEndian.Uint16(data)
+	if minutes == unsetTimeBinary {
+		*t = Time{}
+		return nil
+	}
+	res, err := newTimeFromMinutes(int(minutes))
+	if err != nil {
+		return err
+	}
+	*t = res
+	return nil
+}
+
 func prepareNumber(s string, isDecimal bool) string {
 	for i := range s {
 		if s[i] >= '0' && s[i] <= '9' {
@@ -339,6 +998,62 @@ const (
 	NotSoonPriority
 )
 
+var sortingPriorityNames = map[SortingPriority]string{
+	LongAgoPriority: "long_ago",
+	BeforePriority:  "before",
+	AfterPriority:   "after",
+	NotSoonPriority: "not_soon",
+}
+
+// ParseSortingPriority returns SortingPriority from its string representation.
+func ParseSortingPriority(s string) (SortingPriority, error) {
+	for p, name := range sortingPriorityNames {
+		if name == s {
+			return p, nil
+		}
+	}
+	return 0, fmt.Errorf("invalid sorting priority=%s", s)
+}
+
+// String returns string representation of SortingPriority: long_ago, before, after or not_soon.
+func (p SortingPriority) String() string {
+	if name, ok := sortingPriorityNames[p]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// MarshalJSON implements json.Marshaler interface to marshal SortingPriority to JSON string.
+// Zero and unknown values are marshaled as numbers, so they don't break marshaling of a struct.
+func (p SortingPriority) MarshalJSON() ([]byte, error) {
+	name, ok := sortingPriorityNames[p]
+	if !ok {
+		return json.Marshal(int(p))
+	}
+	return json.Marshal(name)
+}
+
+// UnmarshalJSON implements json.Unmarshaler interface to unmarshal SortingPriority from JSON.
+// It accepts both string and numeric representations, numbers are used as is.
+func (p *SortingPriority) UnmarshalJSON(data []byte) error {
+	var n int
+	if err := json.Unmarshal(data, &n); err == nil {
+		*p = SortingPriority(n)
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	res, err := ParseSortingPriority(s)
+	if err != nil {
+		return err
+	}
+	*p = res
+	return nil
+}
+
 // GetTimeSortingPriority returns time priority for sorting.
 // toCheck is time to check, now is current time, dayStart is day start time (00:00 in general, but not always).
 func GetTimeSortingPriority(toCheck, now, dayStart Time) SortingPriority {
@@ -2,6 +2,7 @@ package datetime_test
 
 import (
 	"encoding/json"
+	"fmt"
 	"testing"
 	"time"
 
@@ -26,6 +27,29 @@ func TestNewTime(t *testing.T) {
 	}
 }
 
+func TestNewTimeNormalized(t *testing.T) {
+	cases := []struct {
+		hour, minute int
+		expected     string
+	}{
+		{10, 30, "10:30"},
+		{10, 90, "11:30"},
+		{23, 60, "00:00"},
+		{25, 0, "01:00"},
+		{48, 15, "00:15"},
+		{0, -1, "23:59"},
+		{-1, 0, "23:00"},
+		{-25, -30, "22:30"},
+		{0, 1440 * 3, "00:00"},
+	}
+
+	for _, c := range cases {
+		if result := datetime.NewTimeNormalized(c.hour, c.minute); result.String() != c.expected {
+			t.Errorf("NewTimeNormalized(%d, %d) = %s; want %s", c.hour, c.minute, result, c.expected)
+		}
+	}
+}
+
 func TestNewTimeFromString(t *testing.T) {
 	cases := []struct {
 		input     string
@@ -81,6 +105,21 @@ func TestParseTime(t *testing.T) {
 		{"25:00", "", true},
 		{"23:dd", "", true},
 		{"1/1/1", "", true},
+		{"9:30", "09:30", false},
+		{"09:05", "09:05", false},
+		{"24:00", "", true},
+		{"9:60", "", true},
+		{"9am", "09:00", false},
+		{"9 AM", "09:00", false},
+		{"11pm", "23:00", false},
+		{"12am", "00:00", false},
+		{"12pm", "12:00", false},
+		{"9:30 pm", "21:30", false},
+		{"12:15am", "00:15", false},
+		{"0am", "", true},
+		{"13pm", "", true},
+		{"13:30pm", "", true},
+		{"pm", "", true},
 	}
 
 	for _, c := range cases {
@@ -91,6 +130,244 @@ func TestParseTime(t *testing.T) {
 	}
 }
 
+func TestTimeFromAny(t *testing.T) {
+	cases := []struct {
+		input     interface{}
+		expected  string
+		expectErr bool
+	}{
+		{"10:30", "10:30", false},
+		{"invalid", "", true},
+		{10*time.Hour + 30*time.Minute, "10:30", false},
+		{10*time.Hour + 30*time.Minute + 45*time.Second, "10:30", false},
+		{24 * time.Hour, "", true},
+		{-time.Minute, "", true},
+		{630, "10:30", false},
+		{1440, "", true},
+		{time.Date(2023, 4, 15, 10, 30, 0, 0, time.UTC), "10:30", false},
+		{datetime.NewTime(10, 30), "10:30", false},
+		{10.5, "", true},
+		{nil, "", true},
+	}
+
+	for _, c := range cases {
+		tm, err := datetime.TimeFromAny(c.input)
+		if (err != nil) != c.expectErr || (!c.expectErr && tm.String() != c.expected) {
+			t.Errorf("TimeFromAny(%v) = %v, %v; want %v, %v", c.input, tm, err, c.expected, c.expectErr)
+		}
+	}
+}
+
+func TestAllTimesOfDay(t *testing.T) {
+	cases := []struct {
+		step   time.Duration
+		length int
+		last   string
+	}{
+		{time.Hour, 24, "23:00"},
+		{time.Minute, 1440, "23:59"},
+		{5 * time.Minute, 288, "23:55"},
+		{90 * time.Minute, 16, "22:30"},
+		{7 * time.Minute, 0, ""},
+		{30 * time.Second, 0, ""},
+		{0, 0, ""},
+		{-time.Hour, 0, ""},
+	}
+
+	for _, c := range cases {
+		result := datetime.AllTimesOfDay(c.step)
+		if len(result) != c.length {
+			t.Errorf("AllTimesOfDay(%v) returned %d times; want %d", c.step, len(result), c.length)
+			continue
+		}
+		if c.length == 0 {
+			continue
+		}
+		if result[0].String() != "00:00" || result[len(result)-1].String() != c.last {
+			t.Errorf("AllTimesOfDay(%v) = [%s ... %s]; want [00:00 ... %s]", c.step, result[0], result[len(result)-1], c.last)
+		}
+	}
+}
+
+func BenchmarkParseTime(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := datetime.ParseTime("10:30"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseTimeSeparator(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := datetime.ParseTime("10.30"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestParseTimeDetailed(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+		format   string
+	}{
+		{"10:30", "10:30", datetime.TimeFormatColon},
+		{"10 30", "10:30", datetime.TimeFormatSpace},
+		{"10-30", "10:30", datetime.TimeFormatDash},
+		{"10_30", "10:30", datetime.TimeFormatUnderscore},
+		{"10,30", "10:30", datetime.TimeFormatComma},
+		{"10.30", "10:30", datetime.TimeFormatDot},
+		{"1030", "10:30", datetime.TimeFormatCompact},
+		{"10:30 pm", "22:30", datetime.TimeFormat12Hour},
+	}
+
+	for _, c := range cases {
+		tm, format, err := datetime.ParseTimeDetailed(c.input)
+		if err != nil || tm.String() != c.expected || format != c.format {
+			t.Errorf("ParseTimeDetailed(%s) = %v, %s, %v; want %v, %s", c.input, tm, format, err, c.expected, c.format)
+		}
+	}
+
+	if _, format, err := datetime.ParseTimeDetailed("invalid"); err == nil || format != "" {
+		t.Errorf("ParseTimeDetailed(invalid) = %s, %v; want error", format, err)
+	}
+}
+
+func TestParseDecimalHour(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+		hasError bool
+	}{
+		{"9.5", "09:30", false},
+		{"9.25", "09:15", false},
+		{"0.0", "00:00", false},
+		{"17", "17:00", false},
+		{"23.99", "23:59", false},
+		{"10.3333", "10:20", false},
+		{"23.999", "", true},
+		{"25.0", "", true},
+		{"24", "", true},
+		{"-1", "", true},
+		{"", "", true},
+		{"abc", "", true},
+		{"9.5abc", "", true},
+		{"9.5h", "", true},
+		{"9.5 h", "", true},
+		{"9..5", "", true},
+		{"1e1", "", true},
+		{" 9.5 ", "09:30", false},
+	}
+
+	for _, c := range cases {
+		tm, err := datetime.ParseDecimalHour(c.input)
+		if (err != nil) != c.hasError || (!c.hasError && tm.String() != c.expected) {
+			t.Errorf("ParseDecimalHour(%q) = %v, %v; want %q, error=%t", c.input, tm, err, c.expected, c.hasError)
+		}
+	}
+}
+
+func TestParseTimeWithZone(t *testing.T) {
+	cases := []struct {
+		input    string
+		time     string
+		zone     string
+		hasError bool
+	}{
+		{"10:30 UTC+3", "10:30", "UTC+3", false},
+		{" 10:30   UTC-3:30 ", "10:30", "UTC-3:30", false},
+		{"09:05 Asia/Kolkata", "09:05", "UTC+5:30", false},
+		{"10:30 pm UTC+1", "22:30", "UTC+1", false},
+		{"10:30", "10:30", "", false},
+		{"10 30", "10:30", "", false},
+		{"10:30 pm", "22:30", "", false},
+		{"10:30 UTC+99", "", "", true},
+		{"10:30 Mars/Olympus", "", "", true},
+		{"25:30 UTC+3", "", "", true},
+		{"", "", "", true},
+	}
+
+	for _, c := range cases {
+		tm, tz, err := datetime.ParseTimeWithZone(c.input)
+		if (err != nil) != c.hasError {
+			t.Errorf("ParseTimeWithZone(%q) error = %v; want error=%t", c.input, err, c.hasError)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if tm.String() != c.time {
+			t.Errorf("ParseTimeWithZone(%q) time = %s; want %s", c.input, tm, c.time)
+		}
+		if c.zone == "" {
+			if !tz.IsZero() {
+				t.Errorf("ParseTimeWithZone(%q) zone = %s; want zero", c.input, tz)
+			}
+		} else if tz.IsZero() || tz.String() != c.zone {
+			t.Errorf("ParseTimeWithZone(%q) zone = %s; want %s", c.input, tz, c.zone)
+		}
+	}
+}
+
+func TestParseTimeStrict(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+		hasError bool
+	}{
+		{"10:30", "10:30", false},
+		{"9:05", "09:05", false},
+		{"00:00", "00:00", false},
+		{"1030", "", true},
+		{"10-30", "", true},
+		{"10 30", "", true},
+		{"10.30", "", true},
+		{"10:30 pm", "", true},
+		{"24:00", "", true},
+		{"10:60", "", true},
+		{"", "", true},
+	}
+
+	for _, c := range cases {
+		tm, err := datetime.ParseTimeStrict(c.input)
+		if (err != nil) != c.hasError || (!c.hasError && tm.String() != c.expected) {
+			t.Errorf("ParseTimeStrict(%q) = %v, %v; want %q, error=%t", c.input, tm, err, c.expected, c.hasError)
+		}
+	}
+}
+
+func TestNextOccurrence(t *testing.T) {
+	var (
+		loc   = time.FixedZone("UTC+3", 3*3600)
+		times = []datetime.Time{datetime.NewTime(18, 0), datetime.NewTime(9, 0), datetime.NewTime(12, 30)}
+	)
+
+	cases := []struct {
+		id       string
+		now      time.Time
+		expected time.Time
+	}{
+		{"before_all", time.Date(2023, 4, 15, 7, 0, 0, 0, loc), time.Date(2023, 4, 15, 9, 0, 0, 0, loc)},
+		{"between", time.Date(2023, 4, 15, 10, 0, 0, 0, loc), time.Date(2023, 4, 15, 12, 30, 0, 0, loc)},
+		{"exact", time.Date(2023, 4, 15, 12, 30, 0, 0, loc), time.Date(2023, 4, 15, 12, 30, 0, 0, loc)},
+		{"after_all", time.Date(2023, 4, 15, 19, 0, 0, 0, loc), time.Date(2023, 4, 16, 9, 0, 0, 0, loc)},
+		{"month_end", time.Date(2023, 4, 30, 18, 1, 0, 0, loc), time.Date(2023, 5, 1, 9, 0, 0, 0, loc)},
+		{"other_zone", time.Date(2023, 4, 15, 16, 0, 0, 0, time.UTC), time.Date(2023, 4, 16, 9, 0, 0, 0, loc)},
+	}
+
+	for _, c := range cases {
+		if result := datetime.NextOccurrence(times, c.now, loc); !result.Equal(c.expected) {
+			t.Errorf("%s -> NextOccurrence() = %v; want %v", c.id, result, c.expected)
+		}
+	}
+
+	if result := datetime.NextOccurrence(nil, time.Now(), loc); !result.IsZero() {
+		t.Errorf("NextOccurrence(nil) = %v; want zero time", result)
+	}
+}
+
 func TestTimeRange(t *testing.T) {
 	low := datetime.NewTime(10, 15)
 	high := datetime.NewTime(15, 45)
@@ -160,6 +437,131 @@ func TestRangeUp(t *testing.T) {
 	}
 }
 
+func TestTimeString(t *testing.T) {
+	for _, tm := range datetime.AllTimesOfDay(time.Minute) {
+		if result, expected := tm.String(), tm.Format("15:04"); result != expected {
+			t.Errorf("String() = %s; want %s", result, expected)
+		}
+	}
+	if result := datetime.EmptyTime.String(); result != "00:00" {
+		t.Errorf("String(EmptyTime) = %s; want 00:00", result)
+	}
+}
+
+func BenchmarkTimeString(b *testing.B) {
+	tm := datetime.NewTime(10, 30)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = tm.String()
+	}
+}
+
+func TestTimeAppendFormat(t *testing.T) {
+	buf := []byte("at ")
+	for _, tm := range []datetime.Time{datetime.NewTime(0, 0), datetime.NewTime(9, 5), datetime.NewTime(23, 59)} {
+		if result := string(tm.AppendFormat(buf)); result != "at "+tm.String() {
+			t.Errorf("AppendFormat() = %s; want %s", result, "at "+tm.String())
+		}
+	}
+}
+
+func BenchmarkTimeAppendFormat(b *testing.B) {
+	tm := datetime.NewTime(10, 30)
+	buf := make([]byte, 0, 16)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		buf = tm.AppendFormat(buf[:0])
+	}
+}
+
+func BenchmarkTimeFormat(b *testing.B) {
+	tm := datetime.NewTime(10, 30)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = tm.Format("15:04")
+	}
+}
+
+func TestStringWith(t *testing.T) {
+	tm := datetime.NewTime(9, 5)
+	for _, sep := range []string{":", "-", ".", " ", "h", ""} {
+		if result := tm.StringWith(sep); result != "09"+sep+"05" {
+			t.Errorf("StringWith(%q) = %s; want %s", sep, result, "09"+sep+"05")
+		}
+	}
+	if result := datetime.NewTime(23, 59).StringWith("."); result != "23.59" {
+		t.Errorf("StringWith(.) = %s; want 23.59", result)
+	}
+}
+
+func TestParseISODuration(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected time.Duration
+		hasError bool
+	}{
+		{"PT1H30M", time.Hour + 30*time.Minute, false},
+		{"PT45M", 45 * time.Minute, false},
+		{"P2D", 48 * time.Hour, false},
+		{"P1DT2H3M4S", 26*time.Hour + 3*time.Minute + 4*time.Second, false},
+		{"PT1.5S", 1500 * time.Millisecond, false},
+		{"PT0S", 0, false},
+		{"-PT15M", -15 * time.Minute, false},
+		{"", 0, true},
+		{"P", 0, true},
+		{"PT", 0, true},
+		{"P1DT", 0, true},
+		{"1H30M", 0, true},
+		{"PT1H30", 0, true},
+		{"PTH", 0, true},
+		{"PT30M1H", 0, true},
+		{"PT1H1H", 0, true},
+		{"P1Y", 0, true},
+		{"P1M", 0, true},
+		{"P1W", 0, true},
+		{"PT1D", 0, true},
+		{"PT1..5S", 0, true},
+		{"PT99999999999999999999H", 0, true},
+		{"-PT99999999999999999999H", 0, true},
+		{"P106752D", 0, true},
+		{"P106751D", 106751 * 24 * time.Hour, false},
+	}
+
+	for _, c := range cases {
+		result, err := datetime.ParseISODuration(c.input)
+		if (err != nil) != c.hasError || result != c.expected {
+			t.Errorf("ParseISODuration(%q) = %v, %v; want %v, error=%t", c.input, result, err, c.expected, c.hasError)
+		}
+	}
+}
+
+func TestFormatDuration(t *testing.T) {
+	cases := []struct {
+		input    time.Duration
+		expected string
+	}{
+		{0, "00:00"},
+		{-30 * time.Second, "00:00"},
+		{45 * time.Minute, "00:45"},
+		{-45 * time.Minute, "-00:45"},
+		{-90 * time.Minute, "-01:30"},
+		{-10*time.Hour - 5*time.Minute, "-10:05"},
+		{5*time.Hour + 30*time.Minute, "05:30"},
+		{27 * time.Hour, "27:00"},
+	}
+
+	for _, c := range cases {
+		if result := datetime.FormatDuration(c.input); result != c.expected {
+			t.Errorf("FormatDuration(%v) = %s; want %s", c.input, result, c.expected)
+		}
+	}
+
+	low, high := datetime.NewTime(10, 15), datetime.NewTime(8, 45)
+	if result := datetime.FormatDuration(low.Range(high)); result != "-01:30" {
+		t.Errorf("FormatDuration(Range) = %s; want -01:30", result)
+	}
+}
+
 func TestAddTime(t *testing.T) {
 	start := datetime.NewTime(10, 30)
 	cases := []struct {
@@ -183,6 +585,31 @@ func TestAddTime(t *testing.T) {
 	}
 }
 
+func TestTimeAt(t *testing.T) {
+	base := datetime.NewTime(10, 30)
+	cases := []struct {
+		offset   time.Duration
+		expected string
+	}{
+		{time.Hour*3 + time.Minute*45, "14:15"},
+		{time.Hour*24 + time.Hour*3 + time.Minute*45, "14:15"},
+		{time.Hour*23 + time.Minute*45, "10:15"},
+		{time.Hour * 24, "10:30"},
+		{time.Hour * 25, "11:30"},
+		{time.Minute * 30, "11:00"},
+		{time.Minute * 60, "11:30"},
+		{-time.Minute * 45, "09:45"},
+		{-time.Hour * 11, "23:30"},
+	}
+
+	for _, c := range cases {
+		result := datetime.TimeAt(base, c.offset)
+		if result.String() != c.expected {
+			t.Errorf("TimeAt(%v) = %s; want %s", c.offset, result.String(), c.expected)
+		}
+	}
+}
+
 func TestSubTime(t *testing.T) {
 	start := datetime.NewTime(10, 30)
 	cases := []struct {
@@ -224,6 +651,27 @@ func TestMinutesFromDayBegin(t *testing.T) {
 	}
 }
 
+func TestTimeKey(t *testing.T) {
+	keys := make(map[int]string)
+	for _, tm := range datetime.AllTimesOfDay(time.Minute) {
+		key := tm.Key()
+		if prev, ok := keys[key]; ok {
+			t.Fatalf("Key() collision for %s and %s", prev, tm)
+		}
+		keys[key] = tm.String()
+		if key != tm.MinutesFromDayBegin(datetime.EmptyTime) {
+			t.Errorf("Key(%s) = %d; want %d", tm, key, tm.MinutesFromDayBegin(datetime.EmptyTime))
+		}
+	}
+
+	if datetime.NewTime(10, 30).Key() != datetime.NewTime(10, 30).Key() {
+		t.Error("Key() should be equal for equal times")
+	}
+	if key := datetime.NewTime(10, 30).Key(); key != 630 {
+		t.Errorf("Key(10:30) = %d; want 630", key)
+	}
+}
+
 func TestMinutesTillDayEnd(t *testing.T) {
 	cases := []struct {
 		hour, minute int
@@ -269,6 +717,187 @@ func TestComparisonMethods(t *testing.T) {
 	}
 }
 
+func TestBetween(t *testing.T) {
+	cases := []struct {
+		input, start, end datetime.Time
+		expected          bool
+	}{
+		{datetime.NewTime(12, 0), datetime.NewTime(9, 0), datetime.NewTime(17, 0), true},
+		{datetime.NewTime(9, 0), datetime.NewTime(9, 0), datetime.NewTime(17, 0), true},
+		{datetime.NewTime(17, 0), datetime.NewTime(9, 0), datetime.NewTime(17, 0), true},
+		{datetime.NewTime(17, 1), datetime.NewTime(9, 0), datetime.NewTime(17, 0), false},
+		{datetime.NewTime(23, 0), datetime.NewTime(22, 0), datetime.NewTime(2, 0), true},
+		{datetime.NewTime(2, 0), datetime.NewTime(22, 0), datetime.NewTime(2, 0), true},
+		{datetime.NewTime(12, 0), datetime.NewTime(22, 0), datetime.NewTime(2, 0), false},
+	}
+
+	for _, c := range cases {
+		if result := c.input.Between(c.start, c.end); result != c.expected {
+			t.Errorf("Between(%s, %s, %s) = %v; want %v", c.input, c.start, c.end, result, c.expected)
+		}
+	}
+}
+
+func TestFilterTimes(t *testing.T) {
+	times := []datetime.Time{
+		datetime.NewTime(1, 0),
+		datetime.NewTime(9, 0),
+		datetime.NewTime(12, 0),
+		datetime.NewTime(22, 30),
+		datetime.NewTime(23, 0),
+	}
+
+	check := func(name string, result []datetime.Time, expected []string) {
+		if len(result) != len(expected) {
+			t.Errorf("%s returned %d times; want %d", name, len(result), len(expected))
+			return
+		}
+		for i := range result {
+			if result[i].String() != expected[i] {
+				t.Errorf("%s[%d] = %s; want %s", name, i, result[i], expected[i])
+			}
+		}
+	}
+
+	check("FilterTimesBetween(day)", datetime.FilterTimesBetween(times, datetime.NewTime(9, 0), datetime.NewTime(18, 0)),
+		[]string{"09:00", "12:00"})
+	check("FilterTimesBetween(wrap)", datetime.FilterTimesBetween(times, datetime.NewTime(22, 0), datetime.NewTime(2, 0)),
+		[]string{"01:00", "22:30", "23:00"})
+	check("FilterTimesBetween(empty)", datetime.FilterTimesBetween(nil, datetime.NewTime(22, 0), datetime.NewTime(2, 0)),
+		nil)
+	check("FilterTimesAfter", datetime.FilterTimesAfter(times, datetime.NewTime(12, 0)),
+		[]string{"12:00", "22:30", "23:00"})
+	check("FilterTimesAfter(empty)", datetime.FilterTimesAfter(nil, datetime.NewTime(12, 0)),
+		nil)
+}
+
+func TestSortTimes(t *testing.T) {
+	times := []datetime.Time{datetime.NewTime(10, 0), datetime.NewTime(0, 30), datetime.NewTime(23, 15), datetime.NewTime(9, 59)}
+
+	datetime.SortTimes(times, false)
+	if result := fmt.Sprint(times); result != "[00:30 09:59 10:00 23:15]" {
+		t.Errorf("SortTimes(asc) = %s; want [00:30 09:59 10:00 23:15]", result)
+	}
+	datetime.SortTimes(times, true)
+	if result := fmt.Sprint(times); result != "[23:15 10:00 09:59 00:30]" {
+		t.Errorf("SortTimes(desc) = %s; want [23:15 10:00 09:59 00:30]", result)
+	}
+}
+
+func TestSortTimesUnsetLast(t *testing.T) {
+	newTimes := func() []datetime.Time {
+		return []datetime.Time{
+			datetime.EmptyTime, datetime.NewTime(10, 0), datetime.NewTime(0, 0),
+			datetime.EmptyTime, datetime.NewTime(23, 15),
+		}
+	}
+
+	for _, desc := range []bool{false, true} {
+		times := newTimes()
+		datetime.SortTimesUnsetLast(times, desc)
+
+		expected := []string{"00:00", "10:00", "23:15"}
+		if desc {
+			expected = []string{"23:15", "10:00", "00:00"}
+		}
+		for i, tm := range times[:3] {
+			if tm.IsZero() || tm.String() != expected[i] {
+				t.Errorf("SortTimesUnsetLast(desc=%t)[%d] = %s, set=%t; want %s", desc, i, tm, !tm.IsZero(), expected[i])
+			}
+		}
+		for i, tm := range times[3:] {
+			if !tm.IsZero() {
+				t.Errorf("SortTimesUnsetLast(desc=%t)[%d] = %s; want unset", desc, i+3, tm)
+			}
+		}
+	}
+}
+
+func TestTimesFromTimes(t *testing.T) {
+	ts := []time.Time{
+		time.Date(2023, 4, 15, 10, 30, 45, 0, time.UTC),
+		time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 2, 29, 23, 59, 0, 0, time.FixedZone("UTC+3", 3*3600)),
+	}
+	expected := []string{"10:30", "00:00", "23:59"}
+
+	result := datetime.TimesFromTimes(ts)
+	if len(result) != len(ts) {
+		t.Fatalf("TimesFromTimes() returned %d times; want %d", len(result), len(ts))
+	}
+	for i, tm := range result {
+		if tm.String() != expected[i] || tm.IsZero() {
+			t.Errorf("TimesFromTimes()[%d] = %s; want %s", i, tm, expected[i])
+		}
+	}
+	if result := datetime.TimesFromTimes(nil); len(result) != 0 {
+		t.Errorf("TimesFromTimes(nil) = %v; want empty", result)
+	}
+}
+
+func TestUniqueTimes(t *testing.T) {
+	result := datetime.UniqueTimes([]datetime.Time{
+		datetime.NewTime(10, 0),
+		datetime.NewTime(10, 0),
+		datetime.NewTime(10, 0),
+	})
+	if len(result) != 1 || result[0].String() != "10:00" {
+		t.Errorf("UniqueTimes(duplicates) = %v; want [10:00]", result)
+	}
+
+	result = datetime.UniqueTimes([]datetime.Time{
+		datetime.NewTime(12, 0),
+		datetime.EmptyTime,
+		datetime.NewTime(0, 0),
+		datetime.NewTime(9, 30),
+		datetime.NewTime(12, 0),
+		datetime.EmptyTime,
+		datetime.NewTime(0, 0),
+	})
+	expected := []string{"12:00", "unset", "00:00", "09:30"}
+	if len(result) != len(expected) {
+		t.Fatalf("UniqueTimes(mixed) returned %d times; want %d", len(result), len(expected))
+	}
+	for i, tm := range result {
+		s := tm.String()
+		if tm.IsZero() {
+			s = "unset"
+		}
+		if s != expected[i] {
+			t.Errorf("UniqueTimes(mixed)[%d] = %s; want %s", i, s, expected[i])
+		}
+	}
+
+	if result := datetime.UniqueTimes(nil); len(result) != 0 {
+		t.Errorf("UniqueTimes(nil) = %v; want empty", result)
+	}
+}
+
+func TestInOpenInterval(t *testing.T) {
+	cases := []struct {
+		input, start, end datetime.Time
+		expected          bool
+	}{
+		{datetime.NewTime(12, 0), datetime.NewTime(9, 0), datetime.NewTime(17, 0), true},
+		{datetime.NewTime(9, 0), datetime.NewTime(9, 0), datetime.NewTime(17, 0), false},
+		{datetime.NewTime(17, 0), datetime.NewTime(9, 0), datetime.NewTime(17, 0), false},
+		{datetime.NewTime(9, 1), datetime.NewTime(9, 0), datetime.NewTime(17, 0), true},
+		{datetime.NewTime(18, 0), datetime.NewTime(9, 0), datetime.NewTime(17, 0), false},
+		{datetime.NewTime(23, 0), datetime.NewTime(22, 0), datetime.NewTime(2, 0), true},
+		{datetime.NewTime(1, 59), datetime.NewTime(22, 0), datetime.NewTime(2, 0), true},
+		{datetime.NewTime(22, 0), datetime.NewTime(22, 0), datetime.NewTime(2, 0), false},
+		{datetime.NewTime(2, 0), datetime.NewTime(22, 0), datetime.NewTime(2, 0), false},
+		{datetime.NewTime(12, 0), datetime.NewTime(22, 0), datetime.NewTime(2, 0), false},
+		{datetime.NewTime(12, 0), datetime.NewTime(12, 0), datetime.NewTime(12, 0), false},
+	}
+
+	for _, c := range cases {
+		if result := c.input.InOpenInterval(c.start, c.end); result != c.expected {
+			t.Errorf("InOpenInterval(%s, %s, %s) = %v; want %v", c.input, c.start, c.end, result, c.expected)
+		}
+	}
+}
+
 func TestSmartDiff(t *testing.T) {
 	start := datetime.NewTime(22, 30)
 	end := datetime.NewTime(1, 45)
@@ -279,6 +908,195 @@ func TestSmartDiff(t *testing.T) {
 	}
 }
 
+func TestEarliestFreeSlot(t *testing.T) {
+	crowded := []datetime.Time{
+		datetime.NewTime(22, 0), datetime.NewTime(22, 30), datetime.NewTime(23, 0), datetime.NewTime(23, 30),
+	}
+
+	cases := []struct {
+		id       string
+		start    datetime.Time
+		taken    []datetime.Time
+		gap      time.Duration
+		step     time.Duration
+		expected datetime.Time
+		ok       bool
+	}{
+		{"empty_taken", datetime.NewTime(10, 7), nil, time.Hour, 15 * time.Minute, datetime.NewTime(10, 7), true},
+		{"already_free", datetime.NewTime(10, 0), crowded, time.Hour, 15 * time.Minute, datetime.NewTime(10, 0), true},
+		{"pushed", datetime.NewTime(20, 30), []datetime.Time{datetime.NewTime(21, 0)}, time.Hour, 15 * time.Minute, datetime.NewTime(22, 0), true},
+		{"crowded_wrap", datetime.NewTime(21, 30), crowded, time.Hour, 15 * time.Minute, datetime.NewTime(0, 30), true},
+		{"gap_before_taken", datetime.NewTime(6, 0), []datetime.Time{datetime.NewTime(6, 30)}, time.Hour, 30 * time.Minute, datetime.NewTime(7, 30), true},
+		{"none", datetime.NewTime(10, 0), []datetime.Time{datetime.NewTime(0, 0), datetime.NewTime(12, 0)}, 13 * time.Hour, time.Hour, datetime.Time{}, false},
+		{"invalid_step", datetime.NewTime(10, 0), nil, time.Hour, 0, datetime.Time{}, false},
+	}
+
+	for _, c := range cases {
+		result, ok := c.start.EarliestFreeSlot(c.taken, c.gap, c.step)
+		if ok != c.ok || (ok && !result.EqualTime(c.expected)) {
+			t.Errorf("%s -> EarliestFreeSlot() = %s, %t; want %s, %t", c.id, result, ok, c.expected, c.ok)
+		}
+	}
+}
+
+func TestClockAngles(t *testing.T) {
+	cases := []struct {
+		time            datetime.Time
+		hourDeg, minDeg float64
+	}{
+		{datetime.NewTime(3, 0), 90, 0},
+		{datetime.NewTime(1, 30), 45, 180},
+		{datetime.NewTime(0, 0), 0, 0},
+		{datetime.NewTime(12, 0), 0, 0},
+		{datetime.NewTime(15, 15), 97.5, 90},
+		{datetime.NewTime(23, 59), 359.5, 354},
+	}
+
+	for _, c := range cases {
+		hourDeg, minDeg := c.time.ClockAngles()
+		if hourDeg != c.hourDeg || minDeg != c.minDeg {
+			t.Errorf("%s.ClockAngles() = %v, %v; want %v, %v", c.time, hourDeg, minDeg, c.hourDeg, c.minDeg)
+		}
+	}
+}
+
+func TestIsOnMinuteMultiple(t *testing.T) {
+	cases := []struct {
+		time     datetime.Time
+		m        int
+		expected bool
+	}{
+		{datetime.NewTime(10, 15), 15, true},
+		{datetime.NewTime(10, 0), 15, true},
+		{datetime.NewTime(10, 7), 15, false},
+		{datetime.NewTime(10, 30), 30, true},
+		{datetime.NewTime(10, 45), 30, false},
+		{datetime.NewTime(10, 7), 1, true},
+		{datetime.NewTime(10, 0), 60, true},
+		{datetime.NewTime(10, 30), 60, false},
+		{datetime.NewTime(10, 0), 120, true},
+		{datetime.NewTime(11, 0), 120, false},
+		{datetime.NewTime(10, 0), 0, false},
+		{datetime.NewTime(10, 0), -15, false},
+	}
+
+	for _, c := range cases {
+		if result := c.time.IsOnMinuteMultiple(c.m); result != c.expected {
+			t.Errorf("%s.IsOnMinuteMultiple(%d) = %t; want %t", c.time, c.m, result, c.expected)
+		}
+	}
+}
+
+func TestTimeDiff(t *testing.T) {
+	cases := []struct {
+		start, end datetime.Time
+		mode       datetime.DiffMode
+		expected   time.Duration
+	}{
+		{datetime.NewTime(22, 30), datetime.NewTime(1, 45), datetime.SignedDiff, -20*time.Hour - 45*time.Minute},
+		{datetime.NewTime(22, 30), datetime.NewTime(1, 45), datetime.ForwardDiff, 3*time.Hour + 15*time.Minute},
+		{datetime.NewTime(22, 30), datetime.NewTime(1, 45), datetime.ShortestDiff, 3*time.Hour + 15*time.Minute},
+		{datetime.NewTime(1, 45), datetime.NewTime(22, 30), datetime.SignedDiff, 20*time.Hour + 45*time.Minute},
+		{datetime.NewTime(1, 45), datetime.NewTime(22, 30), datetime.ForwardDiff, 20*time.Hour + 45*time.Minute},
+		{datetime.NewTime(1, 45), datetime.NewTime(22, 30), datetime.ShortestDiff, -3*time.Hour - 15*time.Minute},
+		{datetime.NewTime(12, 0), datetime.NewTime(10, 0), datetime.SignedDiff, -2 * time.Hour},
+		{datetime.NewTime(12, 0), datetime.NewTime(10, 0), datetime.ForwardDiff, 22 * time.Hour},
+		{datetime.NewTime(12, 0), datetime.NewTime(10, 0), datetime.ShortestDiff, -2 * time.Hour},
+		{datetime.NewTime(0, 0), datetime.NewTime(12, 0), datetime.ShortestDiff, 12 * time.Hour},
+		{datetime.NewTime(12, 0), datetime.NewTime(0, 0), datetime.ShortestDiff, 12 * time.Hour},
+		{datetime.NewTime(10, 0), datetime.NewTime(10, 0), datetime.ForwardDiff, 0},
+		{datetime.NewTime(10, 0), datetime.NewTime(10, 0), datetime.ShortestDiff, 0},
+	}
+
+	for _, c := range cases {
+		if result := c.start.Diff(c.end, c.mode); result != c.expected {
+			t.Errorf("Diff(%s, %s, %d) = %v; want %v", c.start, c.end, c.mode, result, c.expected)
+		}
+	}
+}
+
+func TestLogicalDayOffset(t *testing.T) {
+	dayStart := datetime.NewTime(4, 0)
+	cases := []struct {
+		time     datetime.Time
+		dayStart datetime.Time
+		expected int
+	}{
+		{datetime.NewTime(10, 0), dayStart, 0},
+		{datetime.NewTime(4, 0), dayStart, 0},
+		{datetime.NewTime(23, 59), dayStart, 0},
+		{datetime.NewTime(3, 59), dayStart, 1},
+		{datetime.NewTime(0, 0), dayStart, 1},
+		{datetime.NewTime(0, 0), datetime.EmptyTime, 0},
+		{datetime.NewTime(23, 59), datetime.EmptyTime, 0},
+	}
+
+	for _, c := range cases {
+		if result := c.time.LogicalDayOffset(c.dayStart); result != c.expected {
+			t.Errorf("%s.LogicalDayOffset(%s) = %d; want %d", c.time, c.dayStart, result, c.expected)
+		}
+	}
+}
+
+func TestSmartDiffNonZero(t *testing.T) {
+	cases := []struct {
+		start, end datetime.Time
+		smart      time.Duration
+		nonZero    time.Duration
+	}{
+		{datetime.NewTime(10, 0), datetime.NewTime(10, 0), 0, 24 * time.Hour},
+		{datetime.NewTime(0, 0), datetime.NewTime(0, 0), 0, 24 * time.Hour},
+		{datetime.NewTime(10, 0), datetime.NewTime(12, 30), 2*time.Hour + 30*time.Minute, 2*time.Hour + 30*time.Minute},
+		{datetime.NewTime(22, 0), datetime.NewTime(1, 0), 3 * time.Hour, 3 * time.Hour},
+	}
+
+	for _, c := range cases {
+		if result := c.start.SmartDiff(c.end); result != c.smart {
+			t.Errorf("SmartDiff(%s, %s) = %v; want %v", c.start, c.end, result, c.smart)
+		}
+		if result := c.start.SmartDiffNonZero(c.end); result != c.nonZero {
+			t.Errorf("SmartDiffNonZero(%s, %s) = %v; want %v", c.start, c.end, result, c.nonZero)
+		}
+	}
+}
+
+func TestTimeMidpoint(t *testing.T) {
+	cases := []struct {
+		start, end, expected datetime.Time
+	}{
+		{datetime.NewTime(10, 0), datetime.NewTime(12, 0), datetime.NewTime(11, 0)},
+		{datetime.NewTime(22, 0), datetime.NewTime(2, 0), datetime.NewTime(0, 0)},
+		{datetime.NewTime(23, 0), datetime.NewTime(2, 0), datetime.NewTime(0, 30)},
+		{datetime.NewTime(10, 0), datetime.NewTime(10, 3), datetime.NewTime(10, 1)},
+		{datetime.NewTime(10, 0), datetime.NewTime(10, 0), datetime.NewTime(10, 0)},
+	}
+
+	for _, c := range cases {
+		if result := c.start.Midpoint(c.end); !result.EqualTime(c.expected) {
+			t.Errorf("Midpoint(%s, %s) = %s; want %s", c.start, c.end, result, c.expected)
+		}
+	}
+}
+
+func TestDiffComponents(t *testing.T) {
+	cases := []struct {
+		start, end     datetime.Time
+		hours, minutes int
+	}{
+		{datetime.NewTime(23, 30), datetime.NewTime(1, 15), 1, 45},
+		{datetime.NewTime(10, 0), datetime.NewTime(12, 45), 2, 45},
+		{datetime.NewTime(10, 0), datetime.NewTime(10, 0), 0, 0},
+		{datetime.NewTime(10, 1), datetime.NewTime(10, 0), 23, 59},
+	}
+
+	for _, c := range cases {
+		hours, minutes := c.start.DiffComponents(c.end)
+		if hours != c.hours || minutes != c.minutes {
+			t.Errorf("DiffComponents(%s, %s) = %d, %d; want %d, %d", c.start, c.end, hours, minutes, c.hours, c.minutes)
+		}
+	}
+}
+
 func TestRoundDownToFives(t *testing.T) {
 	cases := []struct {
 		input    datetime.Time
@@ -315,6 +1133,71 @@ func TestRoundUpToFives(t *testing.T) {
 	}
 }
 
+func TestRoundToQuarter(t *testing.T) {
+	cases := []struct {
+		input             datetime.Time
+		down, up, nearest string
+	}{
+		{datetime.NewTime(10, 0), "10:00", "10:00", "10:00"},
+		{datetime.NewTime(10, 7), "10:00", "10:15", "10:00"},
+		{datetime.NewTime(10, 8), "10:00", "10:15", "10:15"},
+		{datetime.NewTime(10, 15), "10:15", "10:15", "10:15"},
+		{datetime.NewTime(10, 44), "10:30", "10:45", "10:45"},
+		{datetime.NewTime(10, 59), "10:45", "11:00", "11:00"},
+		{datetime.NewTime(23, 50), "23:45", "00:00", "23:45"},
+		{datetime.NewTime(23, 55), "23:45", "00:00", "00:00"},
+	}
+
+	for _, c := range cases {
+		if result := c.input.RoundDownToQuarter(); result.String() != c.down {
+			t.Errorf("RoundDownToQuarter(%s) = %s; want %s", c.input, result, c.down)
+		}
+		if result := c.input.RoundUpToQuarter(); result.String() != c.up {
+			t.Errorf("RoundUpToQuarter(%s) = %s; want %s", c.input, result, c.up)
+		}
+		if result := c.input.RoundToQuarter(); result.String() != c.nearest {
+			t.Errorf("RoundToQuarter(%s) = %s; want %s", c.input, result, c.nearest)
+		}
+	}
+}
+
+func TestSnap(t *testing.T) {
+	slots := []datetime.Time{
+		datetime.NewTime(18, 0),
+		datetime.NewTime(9, 0),
+		datetime.NewTime(12, 30),
+	}
+
+	cases := []struct {
+		input    datetime.Time
+		up, down string
+	}{
+		{datetime.NewTime(9, 0), "09:00", "09:00"},
+		{datetime.NewTime(12, 30), "12:30", "12:30"},
+		{datetime.NewTime(10, 0), "12:30", "09:00"},
+		{datetime.NewTime(17, 59), "18:00", "12:30"},
+		{datetime.NewTime(20, 0), "09:00", "18:00"},
+		{datetime.NewTime(8, 0), "09:00", "18:00"},
+	}
+
+	for _, c := range cases {
+		if result := c.input.SnapUp(slots); result.String() != c.up {
+			t.Errorf("SnapUp(%s) = %s; want %s", c.input, result, c.up)
+		}
+		if result := c.input.SnapDown(slots); result.String() != c.down {
+			t.Errorf("SnapDown(%s) = %s; want %s", c.input, result, c.down)
+		}
+	}
+
+	tm := datetime.NewTime(10, 0)
+	if result := tm.SnapUp(nil); !result.EqualTime(tm) {
+		t.Errorf("SnapUp(nil) = %s; want %s", result, tm)
+	}
+	if result := tm.SnapDown(nil); !result.EqualTime(tm) {
+		t.Errorf("SnapDown(nil) = %s; want %s", result, tm)
+	}
+}
+
 func TestIsZero(t *testing.T) {
 	if !datetime.EmptyTime.IsZero() {
 		t.Error("EmptyTime should be zero")
@@ -342,6 +1225,34 @@ func TestTimeMarshalJSON(t *testing.T) {
 	}
 }
 
+func TestTimeIsSet(t *testing.T) {
+	if !datetime.NewTime(0, 0).IsSet() {
+		t.Error("NewTime(0, 0).IsSet() = false; want true")
+	}
+	if datetime.EmptyTime.IsSet() || (datetime.Time{}).IsSet() {
+		t.Error("Time{}.IsSet() = true; want false")
+	}
+
+	unset := datetime.NewTime(10, 30).WithSet(false)
+	if unset.IsSet() {
+		t.Error("WithSet(false).IsSet() = true; want false")
+	}
+	if data, err := json.Marshal(unset); err != nil || string(data) != "null" {
+		t.Errorf("json.Marshal(WithSet(false)) = %s, %v; want null", data, err)
+	}
+	if set := unset.WithSet(true); !set.IsSet() || set.String() != "10:30" {
+		t.Errorf("WithSet(true) = %s, set=%t; want 10:30, set=true", set, set.IsSet())
+	}
+
+	midnight := datetime.EmptyTime.WithSet(true)
+	if !midnight.IsSet() || midnight.IsZero() {
+		t.Errorf("EmptyTime.WithSet(true) set=%t, zero=%t; want set and not zero", midnight.IsSet(), midnight.IsZero())
+	}
+	if data, err := json.Marshal(midnight); err != nil || string(data) != `"00:00"` {
+		t.Errorf("json.Marshal(EmptyTime.WithSet(true)) = %s, %v; want \"00:00\"", data, err)
+	}
+}
+
 func TestTimeUnmarshalJSON(t *testing.T) {
 	data := `"10:15"`
 
@@ -358,6 +1269,61 @@ func TestTimeUnmarshalJSON(t *testing.T) {
 	if err != nil || !timeStruct.IsZero() {
 		t.Errorf("UnmarshalJSON(null) = %v, %v; want zero value", timeStruct, err)
 	}
+
+	cases := []struct {
+		input     string
+		expected  string
+		expectErr bool
+	}{
+		{`"10:30"`, "10:30", false},
+		{`630`, "10:30", false},
+		{`0`, "00:00", false},
+		{`1439`, "23:59", false},
+		{`1440`, "", true},
+		{`-1`, "", true},
+		{`10.5`, "", true},
+	}
+
+	for _, c := range cases {
+		timeStruct = datetime.Time{}
+		err = json.Unmarshal([]byte(c.input), &timeStruct)
+		if (err != nil) != c.expectErr || (!c.expectErr && timeStruct.String() != c.expected) {
+			t.Errorf("UnmarshalJSON(%s) = %v, %v; want %v, %v", c.input, timeStruct, err, c.expected, c.expectErr)
+		}
+	}
+}
+
+func TestTimeMarshalBinary(t *testing.T) {
+	cases := []datetime.Time{
+		datetime.NewTime(0, 0),
+		datetime.NewTime(10, 30),
+		datetime.NewTime(23, 59),
+		datetime.EmptyTime,
+	}
+
+	for _, c := range cases {
+		data, err := c.MarshalBinary()
+		if err != nil || len(data) != 2 {
+			t.Errorf("MarshalBinary(%s) = %v, %v; want 2 bytes", c, data, err)
+			continue
+		}
+		var result datetime.Time
+		if err := result.UnmarshalBinary(data); err != nil {
+			t.Errorf("UnmarshalBinary(%v) error = %v", data, err)
+			continue
+		}
+		if !result.EqualTime(c) || result.IsZero() != c.IsZero() {
+			t.Errorf("UnmarshalBinary(%v) = %s (zero %v); want %s (zero %v)", data, result, result.IsZero(), c, c.IsZero())
+		}
+	}
+
+	var result datetime.Time
+	if err := result.UnmarshalBinary([]byte{0x05, 0xA0}); err == nil {
+		t.Error("UnmarshalBinary should fail for 1440 minutes")
+	}
+	if err := result.UnmarshalBinary([]byte{0x00}); err == nil {
+		t.Error("UnmarshalBinary should fail for invalid length")
+	}
 }
 
 // текущее время (допустим 00 15) меньше времени начала дня (допустим 04 00)
@@ -451,3 +1417,117 @@ func TestGetTimeSortingPriority(t *testing.T) {
 		}
 	}
 }
+
+func TestSortingPriorityString(t *testing.T) {
+	cases := []struct {
+		priority datetime.SortingPriority
+		expected string
+	}{
+		{datetime.LongAgoPriority, "long_ago"},
+		{datetime.BeforePriority, "before"},
+		{datetime.AfterPriority, "after"},
+		{datetime.NotSoonPriority, "not_soon"},
+		{datetime.SortingPriority(0), "unknown"},
+		{datetime.SortingPriority(10), "unknown"},
+	}
+
+	for _, c := range cases {
+		if result := c.priority.String(); result != c.expected {
+			t.Errorf("String(%d) = %s; want %s", c.priority, result, c.expected)
+		}
+		p, err := datetime.ParseSortingPriority(c.expected)
+		if c.expected == "unknown" {
+			if err == nil {
+				t.Errorf("ParseSortingPriority(%s) should return error", c.expected)
+			}
+			continue
+		}
+		if err != nil || p != c.priority {
+			t.Errorf("ParseSortingPriority(%s) = %d, %v; want %d", c.expected, p, err, c.priority)
+		}
+	}
+}
+
+func TestSortingPriorityJSON(t *testing.T) {
+	priorities := []datetime.SortingPriority{
+		datetime.LongAgoPriority,
+		datetime.BeforePriority,
+		datetime.AfterPriority,
+		datetime.NotSoonPriority,
+	}
+
+	for _, p := range priorities {
+		data, err := json.Marshal(p)
+		if err != nil || string(data) != `"`+p.String()+`"` {
+			t.Errorf("MarshalJSON(%d) = %s, %v; want %q", p, data, err, p.String())
+			continue
+		}
+		var result datetime.SortingPriority
+		if err := json.Unmarshal(data, &result); err != nil || result != p {
+			t.Errorf("UnmarshalJSON(%s) = %d, %v; want %d", data, result, err, p)
+		}
+	}
+
+	for _, p := range []datetime.SortingPriority{0, 7} {
+		data, err := json.Marshal(p)
+		if err != nil || string(data) != fmt.Sprint(int(p)) {
+			t.Errorf("MarshalJSON(%d) = %s, %v; want %d", p, data, err, p)
+		}
+	}
+	data, err := json.Marshal(struct{ P datetime.SortingPriority }{})
+	if err != nil || string(data) != `{"P":0}` {
+		t.Errorf("json.Marshal(zero field) = %s, %v; want {\"P\":0}", data, err)
+	}
+	var zero struct{ P datetime.SortingPriority }
+	if err := json.Unmarshal(data, &zero); err != nil || zero.P != 0 {
+		t.Errorf("json.Unmarshal(%s) = %d, %v; want 0", data, zero.P, err)
+	}
+
+	cases := []struct {
+		input     string
+		expected  datetime.SortingPriority
+		expectErr bool
+	}{
+		{`2`, datetime.BeforePriority, false},
+		{`4`, datetime.NotSoonPriority, false},
+		{`"after"`, datetime.AfterPriority, false},
+		{`0`, 0, false},
+		{`5`, 5, false},
+		{`"soon"`, 0, true},
+		{`true`, 0, true},
+	}
+
+	for _, c := range cases {
+		var result datetime.SortingPriority
+		err := json.Unmarshal([]byte(c.input), &result)
+		if (err != nil) != c.expectErr || (!c.expectErr && result != c.expected) {
+			t.Errorf("UnmarshalJSON(%s) = %d, %v; want %d, %v", c.input, result, err, c.expected, c.expectErr)
+		}
+	}
+}
+
+func TestTimeShiftByOffset(t *testing.T) {
+	cases := []struct {
+		id       string
+		time     datetime.Time
+		offset   int
+		expected datetime.Time
+		dayShift int
+	}{
+		{"no_shift", datetime.NewTime(10, 30), 0, datetime.NewTime(10, 30), 0},
+		{"plus_2h", datetime.NewTime(10, 30), 2 * 3600, datetime.NewTime(12, 30), 0},
+		{"plus_2h_midnight", datetime.NewTime(23, 15), 2 * 3600, datetime.NewTime(1, 15), 1},
+		{"minus_3h_midnight", datetime.NewTime(1, 0), -3 * 3600, datetime.NewTime(22, 0), -1},
+		{"minus_to_midnight", datetime.NewTime(3, 0), -3 * 3600, datetime.NewTime(0, 0), 0},
+		{"plus_to_midnight", datetime.NewTime(22, 0), 2 * 3600, datetime.NewTime(0, 0), 1},
+		{"half_hour", datetime.NewTime(12, 0), 5*3600 + 45*60, datetime.NewTime(17, 45), 0},
+		{"seconds_dropped", datetime.NewTime(12, 0), 90, datetime.NewTime(12, 1), 0},
+	}
+
+	for _, c := range cases {
+		result, dayShift := c.time.ShiftByOffset(c.offset)
+		if !result.EqualTime(c.expected) || dayShift != c.dayShift {
+			t.Errorf("%s -> ShiftByOffset(%d) = %s, %d; want %s, %d", c.id, c.offset, result, dayShift, c.expected, c.dayShift)
+		}
+	}
+}
@@ -6,12 +6,15 @@ import (
 	"fmt"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
 // Timezone is a data structure to store timezone in UTC(+|-)HH:MM format.
 type Timezone struct {
 	loc    *time.Location
+	source *time.Location
 	offset int
 }
 
@@ -27,6 +30,7 @@ func NewTimezone(loc *time.Location) Timezone {
 func NewTimezoneFromTime(t time.Time) Timezone {
 	_, offset := t.Zone()
 	out := Timezone{
+		source: t.Location(),
 		offset: offset,
 	}
 
@@ -58,6 +62,10 @@ func ParseTimezone(s string) (Timezone, error) {
 		return Timezone{}, fmt.Errorf("invalid timezone: %s", s)
 	}
 
+	if loc, ok := locationCache.Load(s); ok {
+		return NewTimezone(loc.(*time.Location)), nil
+	}
+
 	loc, err := time.LoadLocation(s)
 	if err != nil {
 		loc, err = ParseUTCOffset(s)
@@ -65,15 +73,128 @@ func ParseTimezone(s string) (Timezone, error) {
 			return Timezone{}, err
 		}
 	}
+	cacheLocation(s, loc)
 
 	return NewTimezone(loc), nil
 }
 
+// ParseTimezoneAllowed returns Timezone parsed by ParseTimezone if its canonical form returned by String,
+// e.g. "UTC+3", is in allowed list. Otherwise it returns an error.
+func ParseTimezoneAllowed(s string, allowed []string) (Timezone, error) {
+	tz, err := ParseTimezone(s)
+	if err != nil {
+		return Timezone{}, err
+	}
+	name := tz.String()
+	for _, a := range allowed {
+		if a == name {
+			return tz, nil
+		}
+	}
+	return Timezone{}, fmt.Errorf("timezone is not allowed: %s", name)
+}
+
+// maxLocationCacheSize limits number of cached locations, so user input cannot grow the cache unbounded.
+const maxLocationCacheSize = 1024
+
+var (
+	// locationCache stores locations parsed by ParseTimezone. Location is cached instead of Timezone,
+	// because offset of Timezone depends on the current time for zones with DST.
+	locationCache     sync.Map
+	locationCacheSize int64
+)
+
+func cacheLocation(s string, loc *time.Location) {
+	if atomic.AddInt64(&locationCacheSize, 1) > maxLocationCacheSize {
+		atomic.AddInt64(&locationCacheSize, -1)
+		return
+	}
+	if _, loaded := locationCache.LoadOrStore(s, loc); loaded {
+		atomic.AddInt64(&locationCacheSize, -1)
+	}
+}
+
+// ParseTimezoneOrUTC returns Timezone parsed by ParseTimezone and true.
+// If input cannot be parsed, it returns UTC timezone and false.
+func ParseTimezoneOrUTC(s string) (Timezone, bool) {
+	tz, err := ParseTimezone(s)
+	if err != nil {
+		return NewTimezone(time.UTC), false
+	}
+	return tz, true
+}
+
 // Loc returns [time.Location] associated with Timezone.
 func (i Timezone) Loc() *time.Location {
 	return i.loc
 }
 
+// IsZero returns true if Timezone is not initialized, e.g. returned by ParseTimeWithZone for input without zone.
+func (i Timezone) IsZero() bool {
+	return i.loc == nil
+}
+
+// IsValidWallClock returns true if provided time exists on provided date in the location of Timezone.
+// It returns false for times skipped by a forward DST transition, e.g. 02:30 on a spring-forward day.
+func (i Timezone) IsValidWallClock(d Date, t Time) bool {
+	res := time.Date(d.Year(), d.Month(), d.Day(), t.Hour(), t.Minute(), 0, 0, i.sourceLoc())
+	return res.Day() == d.Day() && res.Hour() == t.Hour() && res.Minute() == t.Minute()
+}
+
+// DifferenceFrom returns signed difference between offsets of Timezone and other Timezone.
+// It is positive if Timezone is ahead of other.
+func (i Timezone) DifferenceFrom(other Timezone) time.Duration {
+	return time.Duration(i.offset-other.offset) * time.Second
+}
+
+// HoursDifference returns signed difference between offsets of Timezone and other Timezone in fractional hours,
+// e.g. 2.5 for 2h30m. It is positive if Timezone is ahead of other.
+func (i Timezone) HoursDifference(other Timezone) float64 {
+	return i.DifferenceFrom(other).Hours()
+}
+
+// DifferenceAt returns signed difference between offsets of Timezone and other Timezone at provided instant.
+// It takes into account DST rules of the locations Timezones were created from.
+func (i Timezone) DifferenceAt(other Timezone, t time.Time) time.Duration {
+	_, offset := t.In(i.sourceLoc()).Zone()
+	_, otherOffset := t.In(other.sourceLoc()).Zone()
+	return time.Duration(offset-otherOffset) * time.Second
+}
+
+// sourceLoc returns location Timezone was created from.
+func (i Timezone) sourceLoc() *time.Location {
+	if i.source != nil {
+		return i.source
+	}
+	if i.loc != nil {
+		return i.loc
+	}
+	return time.UTC
+}
+
+// IsRealWorld returns true if offset of Timezone is observed by at least one real timezone,
+// either as a standard or as a daylight saving time offset. E.g. UTC+5:45 is real, UTC+7:30 is not.
+func (i Timezone) IsRealWorld() bool {
+	if i.offset%60 != 0 {
+		return false
+	}
+	minutes := i.offset / 60
+	for _, offset := range observedOffsets {
+		if offset == minutes {
+			return true
+		}
+	}
+	return false
+}
+
+// observedOffsets is a sorted list of UTC offsets in minutes that are currently observed in real world.
+var observedOffsets = []int{
+	-12 * 60, -11 * 60, -10 * 60, -9*60 - 30, -9 * 60, -8 * 60, -7 * 60, -6 * 60, -5 * 60, -4 * 60,
+	-3*60 - 30, -3 * 60, -2*60 - 30, -2 * 60, -1 * 60, 0, 1 * 60, 2 * 60, 3 * 60, 3*60 + 30,
+	4 * 60, 4*60 + 30, 5 * 60, 5*60 + 30, 5*60 + 45, 6 * 60, 6*60 + 30, 7 * 60, 8 * 60, 8*60 + 45,
+	9 * 60, 9*60 + 30, 10 * 60, 10*60 + 30, 11 * 60, 12 * 60, 12*60 + 45, 13 * 60, 13*60 + 45, 14 * 60,
+}
+
 // Offset returns offset in seconds.
 func (i Timezone) Offset() int {
 	return i.offset
@@ -107,7 +228,7 @@ func (i *Timezone) UnmarshalJSON(data []byte) error {
 	if err != nil {
 		return err
 	}
-	i.loc = loc.loc
+	*i = loc
 
 	return nil
 }
@@ -118,6 +239,10 @@ func ParseUTCOffset(input string) (*time.Location, error) {
 		return nil, errors.New("input cannot be empty")
 	}
 	input = strings.TrimSpace(strings.Replace(input, "UTC", "", 1))
+	if len(input) == 0 {
+		// Input is "UTC" without offset.
+		input = "0"
+	}
 
 	sign := byte('+')
 	if input[0] == '+' || input[0] == '-' {
@@ -147,56 +272,101 @@ func ParseUTCOffset(input string) (*time.Location, error) {
 	if err != nil {
 		return nil, fmt.Errorf("invalid input %s and hours %s", input, hours)
 	}
+	minutesInt, err := strconv.Atoi(minutes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid input %s and minutes %s", input, minutes)
+	}
+	if err := checkUTCOffset(sign, hoursInt, minutesInt); err != nil {
+		return nil, err
+	}
+	signInt := 1
+	if sign == '-' {
+		signInt = -1
+	}
+
+	loc := strings.Builder{}
+	loc.WriteString("UTC")
+	loc.WriteByte(sign)
+	loc.WriteString(hours)
+	if minutesInt > 0 {
+		loc.WriteString(":" + minutes)
+	}
+	return time.FixedZone(loc.String(), signInt*hoursInt*60*60+signInt*minutesInt*60), nil
+}
+
+// NewFixedTimezone returns Timezone with fixed offset from UTC, sign should be 1 or -1.
+// Offset is validated with the same rules as in ParseUTCOffset, e.g. hours=5, minutes=45, sign=1 is UTC+5:45.
+func NewFixedTimezone(hours, minutes, sign int) (Timezone, error) {
+	if sign != 1 && sign != -1 {
+		return Timezone{}, fmt.Errorf("sign should be 1 or -1, got: %d", sign)
+	}
+	if hours < 0 {
+		return Timezone{}, fmt.Errorf("hours should not be negative: %d", hours)
+	}
+	signByte := byte('+')
+	if sign < 0 {
+		signByte = '-'
+	}
+	if err := checkUTCOffset(signByte, hours, minutes); err != nil {
+		return Timezone{}, err
+	}
+	offset := sign * (hours*60*60 + minutes*60)
+	return NewTimezone(time.FixedZone("", offset)), nil
+}
+
+// AllUTCOffsets returns all fixed-offset timezones accepted by ParseUTCOffset and NewFixedTimezone,
+// sorted by offset from UTC-12 to UTC+14.
+func AllUTCOffsets() []Timezone {
+	var out []Timezone
+	for m := -12 * 60; m <= 14*60; m += 15 {
+		sign, abs := 1, m
+		if m < 0 {
+			sign, abs = -1, -m
+		}
+		if tz, err := NewFixedTimezone(abs/60, abs%60, sign); err == nil {
+			out = append(out, tz)
+		}
+	}
+	return out
+}
+
+// checkUTCOffset returns an error if offset is not allowed: hours should be up to 14 for positive
+// and up to 12 for negative offsets, minutes should be 0, 30 or 45 for a limited set of hours.
+func checkUTCOffset(sign byte, hoursInt, minutesInt int) error {
 	hoursThreshold := 14
 	if sign == '-' {
 		hoursThreshold = 12
 	}
 	if hoursInt > hoursThreshold {
-		return nil, fmt.Errorf("hours should be less than %d: %s", hoursThreshold, hours)
-	}
-	minutesInt, err := strconv.Atoi(minutes)
-	if err != nil {
-		return nil, fmt.Errorf("invalid input %s and minutes %s", input, minutes)
+		return fmt.Errorf("hours should be less than %d: %d", hoursThreshold, hoursInt)
 	}
 	if !isEqual(minutesInt, 0, 30, 45) {
-		return nil, fmt.Errorf("minutes can be equal to 0, 30 or 45, got: %d", minutesInt)
+		return fmt.Errorf("minutes can be equal to 0, 30 or 45, got: %d", minutesInt)
 	}
 	if minutesInt == 30 {
 		if sign == '+' {
 			if !isEqual(hoursInt, 3, 4, 5, 6, 9, 10) {
-				return nil, fmt.Errorf("invalid hour %s%d for minute %d", string(sign), hoursInt, minutesInt)
+				return fmt.Errorf("invalid hour %s%d for minute %d", string(sign), hoursInt, minutesInt)
 			}
 		}
 		if sign == '-' {
 			if !isEqual(hoursInt, 3, 9) {
-				return nil, fmt.Errorf("invalid hour %s%d for minute %d", string(sign), hoursInt, minutesInt)
+				return fmt.Errorf("invalid hour %s%d for minute %d", string(sign), hoursInt, minutesInt)
 			}
 		}
 	}
 	if minutesInt == 45 {
 		if sign == '-' {
-			return nil, fmt.Errorf("invalid hour %s%d for minute %d", string(sign), hoursInt, minutesInt)
+			return fmt.Errorf("invalid hour %s%d for minute %d", string(sign), hoursInt, minutesInt)
 		}
 		if sign == '+' {
 			if !isEqual(hoursInt, 5, 8, 12) {
-				return nil, fmt.Errorf("invalid hour %s%d for minute %d", string(sign), hoursInt, minutesInt)
+				return fmt.Errorf("invalid hour %s%d for minute %d", string(sign), hoursInt, minutesInt)
 			}
 		}
 
 	}
-	signInt := 1
-	if sign == '-' {
-		signInt = -1
-	}
-
-	loc := strings.Builder{}
-	loc.WriteString("UTC")
-	loc.WriteByte(sign)
-	loc.WriteString(hours)
-	if minutesInt > 0 {
-		loc.WriteString(":" + minutes)
-	}
-	return time.FixedZone(loc.String(), signInt*hoursInt*60*60+signInt*minutesInt*60), nil
+	return nil
 }
 
 func isEqual(n int, ns ...int) bool {
@@ -81,6 +81,303 @@ func TestParseTimezone(t *testing.T) {
 	}
 }
 
+func TestParseTimezoneCached(t *testing.T) {
+	for _, input := range []string{"Europe/Moscow", "America/New_York", "UTC+5:45", "UTC-3"} {
+		first, err := datetime.ParseTimezone(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		second, err := datetime.ParseTimezone(input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if first.String() != second.String() || first.Offset() != second.Offset() {
+			t.Errorf("ParseTimezone(%s) = %s (%d) after caching; want %s (%d)", input, second, second.Offset(), first, first.Offset())
+		}
+	}
+
+	if _, err := datetime.ParseTimezone("Invalid/Zone"); err == nil {
+		t.Error("ParseTimezone should fail for invalid zone")
+	}
+	if _, err := datetime.ParseTimezone("Invalid/Zone"); err == nil {
+		t.Error("ParseTimezone should fail for invalid zone after first call")
+	}
+}
+
+func BenchmarkParseTimezone(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := datetime.ParseTimezone("Europe/Moscow"); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkLoadLocation(b *testing.B) {
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		loc, err := time.LoadLocation("Europe/Moscow")
+		if err != nil {
+			b.Fatal(err)
+		}
+		datetime.NewTimezone(loc)
+	}
+}
+
+func TestParseTimezoneOrUTC(t *testing.T) {
+	cases := []struct {
+		input    string
+		expected string
+		ok       bool
+	}{
+		{"UTC+02:00", "UTC+2", true},
+		{"Europe/Moscow", "UTC+3", true},
+		{"Invalid/Zone", "UTC", false},
+		{"garbage", "UTC", false},
+		{"", "UTC", false},
+	}
+
+	for _, c := range cases {
+		tz, ok := datetime.ParseTimezoneOrUTC(c.input)
+		if ok != c.ok || tz.String() != c.expected {
+			t.Errorf("ParseTimezoneOrUTC(%s) = %s, %v; want %s, %v", c.input, tz, ok, c.expected, c.ok)
+		}
+	}
+}
+
+func TestParseTimezoneAllowed(t *testing.T) {
+	allowed := []string{"UTC", "UTC+3", "UTC+5:30"}
+	cases := []struct {
+		input    string
+		expected string
+		hasError bool
+	}{
+		{"UTC+3", "UTC+3", false},
+		{"+03:00", "UTC+3", false},
+		{"Europe/Moscow", "UTC+3", false},
+		{"Asia/Kolkata", "UTC+5:30", false},
+		{"UTC", "UTC", false},
+		{"UTC+4", "", true},
+		{"Asia/Dubai", "", true},
+		{"invalid", "", true},
+	}
+
+	for _, c := range cases {
+		tz, err := datetime.ParseTimezoneAllowed(c.input, allowed)
+		if (err != nil) != c.hasError || (!c.hasError && tz.String() != c.expected) {
+			t.Errorf("ParseTimezoneAllowed(%s) = %s, %v; want %s, error=%t", c.input, tz, err, c.expected, c.hasError)
+		}
+	}
+
+	if _, err := datetime.ParseTimezoneAllowed("UTC", nil); err == nil {
+		t.Error("ParseTimezoneAllowed(UTC, nil) error = nil; want error")
+	}
+}
+
+func TestIsValidWallClock(t *testing.T) {
+	tz, err := datetime.ParseTimezone("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var (
+		springForward = datetime.NewDate(2023, 3, 12)
+		fallBack      = datetime.NewDate(2023, 11, 5)
+	)
+
+	cases := []struct {
+		date     datetime.Date
+		time     datetime.Time
+		expected bool
+	}{
+		{springForward, datetime.NewTime(1, 59), true},
+		{springForward, datetime.NewTime(2, 0), false},
+		{springForward, datetime.NewTime(2, 30), false},
+		{springForward, datetime.NewTime(3, 0), true},
+		{fallBack, datetime.NewTime(1, 30), true},
+		{datetime.NewDate(2023, 3, 13), datetime.NewTime(2, 30), true},
+	}
+
+	for _, c := range cases {
+		if result := tz.IsValidWallClock(c.date, c.time); result != c.expected {
+			t.Errorf("IsValidWallClock(%s %s) = %v; want %v", c.date, c.time, result, c.expected)
+		}
+	}
+
+	fixed := datetime.NewTimezone(time.FixedZone("", -5*3600))
+	if !fixed.IsValidWallClock(springForward, datetime.NewTime(2, 30)) {
+		t.Error("IsValidWallClock should return true for fixed offset timezone")
+	}
+}
+
+func TestTimezoneDifference(t *testing.T) {
+	plus5, err := datetime.ParseTimezone("UTC+5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	minus3, err := datetime.ParseTimezone("UTC-3:30")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := plus5.DifferenceFrom(minus3); d != 8*time.Hour+30*time.Minute {
+		t.Errorf("DifferenceFrom() = %v; want %v", d, 8*time.Hour+30*time.Minute)
+	}
+	if d := minus3.DifferenceFrom(plus5); d != -8*time.Hour-30*time.Minute {
+		t.Errorf("DifferenceFrom() = %v; want %v", d, -8*time.Hour-30*time.Minute)
+	}
+
+	newYork, err := datetime.ParseTimezone("America/New_York")
+	if err != nil {
+		t.Fatal(err)
+	}
+	moscow, err := datetime.ParseTimezone("Europe/Moscow")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	summer := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+	if d := moscow.DifferenceAt(newYork, summer); d != 7*time.Hour {
+		t.Errorf("DifferenceAt(summer) = %v; want %v", d, 7*time.Hour)
+	}
+	winter := time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC)
+	if d := moscow.DifferenceAt(newYork, winter); d != 8*time.Hour {
+		t.Errorf("DifferenceAt(winter) = %v; want %v", d, 8*time.Hour)
+	}
+	if d := plus5.DifferenceAt(minus3, winter); d != plus5.DifferenceFrom(minus3) {
+		t.Errorf("DifferenceAt(fixed) = %v; want %v", d, plus5.DifferenceFrom(minus3))
+	}
+}
+
+func TestTimezoneHoursDifference(t *testing.T) {
+	cases := []struct {
+		tz, other string
+		expected  float64
+	}{
+		{"UTC+5", "UTC+3", 2},
+		{"UTC+5:30", "UTC+3", 2.5},
+		{"UTC+5:45", "UTC", 5.75},
+		{"UTC-3:30", "UTC+1", -4.5},
+		{"UTC+12:45", "UTC+5:30", 7.25},
+		{"UTC+3", "UTC+3", 0},
+	}
+
+	for _, c := range cases {
+		tz, err := datetime.ParseTimezone(c.tz)
+		if err != nil {
+			t.Fatal(err)
+		}
+		other, err := datetime.ParseTimezone(c.other)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if result := tz.HoursDifference(other); result != c.expected {
+			t.Errorf("%s.HoursDifference(%s) = %v; want %v", c.tz, c.other, result, c.expected)
+		}
+	}
+}
+
+func TestIsRealWorld(t *testing.T) {
+	cases := []struct {
+		offset   int
+		expected bool
+	}{
+		{0, true},
+		{getOffset(5, 45, 1), true},
+		{getOffset(5, 30, 1), true},
+		{getOffset(9, 30, -1), true},
+		{getOffset(14, 0, 1), true},
+		{getOffset(12, 0, -1), true},
+		{getOffset(7, 30, 1), false},
+		{getOffset(2, 15, 1), false},
+		{getOffset(15, 0, 1), false},
+		{getOffset(13, 0, -1), false},
+		{getOffset(5, 45, 1) + 10, false},
+	}
+
+	for _, c := range cases {
+		tz := datetime.NewTimezone(time.FixedZone("", c.offset))
+		if result := tz.IsRealWorld(); result != c.expected {
+			t.Errorf("IsRealWorld(%s) = %v; want %v", tz, result, c.expected)
+		}
+	}
+
+	tz, err := datetime.ParseTimezone("Asia/Kathmandu")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !tz.IsRealWorld() {
+		t.Errorf("IsRealWorld(%s) = false; want true", tz)
+	}
+}
+
+func TestNewFixedTimezone(t *testing.T) {
+	cases := []struct {
+		hours, minutes, sign int
+		expected             string
+		hasError             bool
+	}{
+		{5, 45, 1, "UTC+5:45", false},
+		{12, 0, -1, "UTC-12", false},
+		{14, 0, 1, "UTC+14", false},
+		{3, 30, -1, "UTC-3:30", false},
+		{0, 0, 1, "UTC", false},
+		{15, 0, 1, "", true},
+		{13, 0, -1, "", true},
+		{7, 30, 1, "", true},
+		{5, 15, 1, "", true},
+		{5, 45, -1, "", true},
+		{-5, 0, 1, "", true},
+		{5, 0, 0, "", true},
+	}
+
+	for _, c := range cases {
+		tz, err := datetime.NewFixedTimezone(c.hours, c.minutes, c.sign)
+		if (err != nil) != c.hasError {
+			t.Errorf("NewFixedTimezone(%d, %d, %d) error = %v; want error=%t", c.hours, c.minutes, c.sign, err, c.hasError)
+			continue
+		}
+		if err != nil {
+			continue
+		}
+		if tz.String() != c.expected || tz.Offset() != getOffset(c.hours, c.minutes, c.sign) {
+			t.Errorf("NewFixedTimezone(%d, %d, %d) = %s, %d; want %s, %d", c.hours, c.minutes, c.sign,
+				tz, tz.Offset(), c.expected, getOffset(c.hours, c.minutes, c.sign))
+		}
+	}
+}
+
+func TestAllUTCOffsets(t *testing.T) {
+	offsets := datetime.AllUTCOffsets()
+	if len(offsets) == 0 {
+		t.Fatal("AllUTCOffsets() returned empty list")
+	}
+	if first := offsets[0].String(); first != "UTC-12" {
+		t.Errorf("AllUTCOffsets()[0] = %s; want UTC-12", first)
+	}
+	if last := offsets[len(offsets)-1].String(); last != "UTC+14" {
+		t.Errorf("AllUTCOffsets()[last] = %s; want UTC+14", last)
+	}
+
+	names := make(map[string]bool, len(offsets))
+	for i, tz := range offsets {
+		if i > 0 && tz.Offset() <= offsets[i-1].Offset() {
+			t.Errorf("AllUTCOffsets() is not sorted: %s after %s", tz, offsets[i-1])
+		}
+		if _, err := datetime.ParseUTCOffset(tz.String()); err != nil {
+			t.Errorf("ParseUTCOffset(%s) error = %v", tz, err)
+		}
+		names[tz.String()] = true
+	}
+	for _, name := range []string{"UTC", "UTC+5:45", "UTC+5:30", "UTC-9:30", "UTC+14", "UTC-12"} {
+		if !names[name] {
+			t.Errorf("AllUTCOffsets() doesn't contain %s", name)
+		}
+	}
+	if names["UTC+7:30"] {
+		t.Error("AllUTCOffsets() contains UTC+7:30")
+	}
+}
+
 func TestTimezoneMarshalJSON(t *testing.T) {
 	loc := time.FixedZone("TestZone", 3600)
 	tz := datetime.NewTimezone(loc)
@@ -250,6 +547,11 @@ func TestParseUTCOffset(t *testing.T) {
 			input: "13 a",
 			isErr: true,
 		},
+		{
+			id:     "26",
+			input:  "UTC",
+			result: utcTime,
+		},
 	}
 
 	for _, test := range testCases {
@@ -0,0 +1,133 @@
+package datetime
+
+import (
+	"fmt"
+	"time"
+)
+
+// Weekend is a set of days of week that are not business days.
+// Zero Weekend has no weekend days, so every day is a business day.
+type Weekend struct {
+	Days []time.Weekday
+}
+
+var defaultWeekend = Weekend{Days: []time.Weekday{time.Saturday, time.Sunday}}
+
+// DefaultWeekend returns Saturday and Sunday weekend, it is used by weekend-aware methods of Date.
+// Every call returns a new Weekend, so changing it doesn't affect these methods.
+func DefaultWeekend() Weekend {
+	return NewWeekend(time.Saturday, time.Sunday)
+}
+
+// NewWeekend returns new Weekend from provided days.
+func NewWeekend(days ...time.Weekday) Weekend {
+	return Weekend{Days: days}
+}
+
+// IsWeekend returns true if date falls on one of weekend days.
+func (w Weekend) IsWeekend(d Date) bool {
+	weekday := d.Weekday()
+	for _, day := range w.Days {
+		if day == weekday {
+			return true
+		}
+	}
+	return false
+}
+
+// IsBusinessDay returns true if date doesn't fall on weekend.
+func (w Weekend) IsBusinessDay(d Date) bool {
+	return !w.IsWeekend(d)
+}
+
+// BusinessDayOfMonth returns number of business day of the date in the month, starting from 1.
+// It returns 0 if date is a weekend.
+func (w Weekend) BusinessDayOfMonth(d Date) int {
+	if w.IsWeekend(d) {
+		return 0
+	}
+	var n int
+	for cur := NewDate(d.Year(), int(d.Month()), 1); !cur.After(d.Time); cur = cur.NextDay() {
+		if w.IsBusinessDay(cur) {
+			n++
+		}
+	}
+	return n
+}
+
+// NextWorkingDay returns the first date strictly after d that is neither a weekend nor one of holidays.
+// It returns zero date if every day of week is a weekend.
+func (w Weekend) NextWorkingDay(d Date, holidays []Date) Date {
+	off := make(map[int]struct{}, len(holidays))
+	for _, h := range holidays {
+		off[h.JulianDayNumber()] = struct{}{}
+	}
+	// Every week has at least one business day, so holidays can postpone the result by at most a week each.
+	for i, cur := 0, d.NextDay(); i < 7*(len(holidays)+1); i, cur = i+1, cur.NextDay() {
+		if _, ok := off[cur.JulianDayNumber()]; !ok && w.IsBusinessDay(cur) {
+			return cur
+		}
+	}
+	return Date{}
+}
+
+// NthBusinessDayOfMonth returns n-th business day of the month, starting from 1.
+func (w Weekend) NthBusinessDayOfMonth(year, month, n int) (Date, error) {
+	if n < 1 {
+		return Date{}, fmt.Errorf("invalid n=%d", n)
+	}
+	first := NewDate(year, month, 1)
+	for d := first; d.Month() == first.Month(); d = d.NextDay() {
+		if w.IsWeekend(d) {
+			continue
+		}
+		n--
+		if n == 0 {
+			return d, nil
+		}
+	}
+	return Date{}, fmt.Errorf("month %d-%02d has less business days than requested", first.Year(), first.Month())
+}
+
+// DaysBetween returns number of weekend days between start and end including both of them.
+func (w Weekend) DaysBetween(start, end Date) int {
+	r := NewDateRange(start, end)
+	var n int
+	for d := r.Start; !d.After(r.End.Time); d = d.NextDay() {
+		if w.IsWeekend(d) {
+			n++
+		}
+	}
+	return n
+}
+
+// WeekendsBetween returns number of whole weekends between start and end including both of them.
+// Weekend is whole if all its consecutive days (e.g. Saturday and Sunday) are inside the range.
+func (w Weekend) WeekendsBetween(start, end Date) int {
+	r := NewDateRange(start, end)
+	var n int
+	for d := r.Start; !d.After(r.End.Time); d = d.NextDay() {
+		if !w.IsWeekend(d) || w.IsWeekend(d.PrevDay()) {
+			continue
+		}
+		last := d
+		for w.IsWeekend(last.NextDay()) {
+			last = last.NextDay()
+		}
+		if !last.After(r.End.Time) {
+			n++
+		}
+	}
+	return n
+}
+
+// WeekendDaysBetween returns number of Saturdays and Sundays between start and end including both of them.
+func WeekendDaysBetween(start, end Date) int {
+	return defaultWeekend.DaysBetween(start, end)
+}
+
+// WeekendsBetween returns number of whole weekends (Saturday and Sunday) between start and end
+// including both of them.
+func WeekendsBetween(start, end Date) int {
+	return defaultWeekend.WeekendsBetween(start, end)
+}
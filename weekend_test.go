@@ -0,0 +1,140 @@
+package datetime_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/maxbolgarin/datetime"
+)
+
+func TestWeekendIsBusinessDay(t *testing.T) {
+	friSat := datetime.NewWeekend(time.Friday, time.Saturday)
+
+	// 2023-04-14 is Friday.
+	cases := []struct {
+		date            datetime.Date
+		defaultBusiness bool
+		friSatBusiness  bool
+	}{
+		{datetime.NewDate(2023, 4, 13), true, true},
+		{datetime.NewDate(2023, 4, 14), true, false},
+		{datetime.NewDate(2023, 4, 15), false, false},
+		{datetime.NewDate(2023, 4, 16), false, true},
+		{datetime.NewDate(2023, 4, 17), true, true},
+	}
+
+	for _, c := range cases {
+		if result := c.date.IsBusinessDay(); result != c.defaultBusiness {
+			t.Errorf("IsBusinessDay(%s) = %v; want %v", c.date, result, c.defaultBusiness)
+		}
+		if result := datetime.DefaultWeekend().IsBusinessDay(c.date); result != c.defaultBusiness {
+			t.Errorf("DefaultWeekend().IsBusinessDay(%s) = %v; want %v", c.date, result, c.defaultBusiness)
+		}
+		if result := friSat.IsBusinessDay(c.date); result != c.friSatBusiness {
+			t.Errorf("Weekend(Fri, Sat).IsBusinessDay(%s) = %v; want %v", c.date, result, c.friSatBusiness)
+		}
+		if result := friSat.IsWeekend(c.date); result == c.friSatBusiness {
+			t.Errorf("Weekend(Fri, Sat).IsWeekend(%s) = %v; want %v", c.date, result, !c.friSatBusiness)
+		}
+	}
+
+	if !(datetime.Weekend{}).IsBusinessDay(datetime.NewDate(2023, 4, 15)) {
+		t.Error("zero Weekend should treat every day as a business day")
+	}
+
+	w := datetime.DefaultWeekend()
+	w.Days[0] = time.Monday
+	if datetime.NewDate(2023, 4, 15).IsBusinessDay() || !datetime.DefaultWeekend().IsWeekend(datetime.NewDate(2023, 4, 15)) {
+		t.Error("changing DefaultWeekend() result should not affect default weekend")
+	}
+}
+
+func TestWeekendBusinessDayOfMonth(t *testing.T) {
+	friSat := datetime.NewWeekend(time.Friday, time.Saturday)
+
+	// April 2023 starts on Saturday.
+	if result := friSat.BusinessDayOfMonth(datetime.NewDate(2023, 4, 2)); result != 1 {
+		t.Errorf("BusinessDayOfMonth(2023-04-02) = %d; want 1", result)
+	}
+	if result := friSat.BusinessDayOfMonth(datetime.NewDate(2023, 4, 7)); result != 0 {
+		t.Errorf("BusinessDayOfMonth(2023-04-07) = %d; want 0", result)
+	}
+	if result := friSat.BusinessDayOfMonth(datetime.NewDate(2023, 4, 9)); result != 6 {
+		t.Errorf("BusinessDayOfMonth(2023-04-09) = %d; want 6", result)
+	}
+
+	date, err := friSat.NthBusinessDayOfMonth(2023, 4, 5)
+	if err != nil || date.String() != "2023-04-06" {
+		t.Errorf("NthBusinessDayOfMonth(2023, 4, 5) = %v, %v; want 2023-04-06", date, err)
+	}
+	if _, err := friSat.NthBusinessDayOfMonth(2023, 4, 30); err == nil {
+		t.Error("NthBusinessDayOfMonth should fail for too big n")
+	}
+}
+
+func TestWeekendDaysBetween(t *testing.T) {
+	// 2023-04-15 is Saturday.
+	cases := []struct {
+		id             string
+		start, end     datetime.Date
+		days, weekends int
+	}{
+		{"same_weekday", datetime.NewDate(2023, 4, 12), datetime.NewDate(2023, 4, 12), 0, 0},
+		{"mid_weekend", datetime.NewDate(2023, 4, 16), datetime.NewDate(2023, 4, 22), 2, 0},
+		{"mid_weekend_long", datetime.NewDate(2023, 4, 16), datetime.NewDate(2023, 4, 23), 3, 1},
+		{"two_weekends", datetime.NewDate(2023, 4, 15), datetime.NewDate(2023, 4, 23), 4, 2},
+		{"two_weeks", datetime.NewDate(2023, 4, 10), datetime.NewDate(2023, 4, 23), 4, 2},
+		{"reversed", datetime.NewDate(2023, 4, 23), datetime.NewDate(2023, 4, 15), 4, 2},
+		{"saturday_only", datetime.NewDate(2023, 4, 15), datetime.NewDate(2023, 4, 15), 1, 0},
+	}
+
+	for _, c := range cases {
+		if result := datetime.WeekendDaysBetween(c.start, c.end); result != c.days {
+			t.Errorf("%s -> WeekendDaysBetween() = %d; want %d", c.id, result, c.days)
+		}
+		if result := datetime.WeekendsBetween(c.start, c.end); result != c.weekends {
+			t.Errorf("%s -> WeekendsBetween() = %d; want %d", c.id, result, c.weekends)
+		}
+	}
+
+	friSat := datetime.NewWeekend(time.Friday, time.Saturday)
+	if result := friSat.WeekendsBetween(datetime.NewDate(2023, 4, 14), datetime.NewDate(2023, 4, 15)); result != 1 {
+		t.Errorf("Weekend(Fri, Sat).WeekendsBetween() = %d; want 1", result)
+	}
+	if result := friSat.DaysBetween(datetime.NewDate(2023, 4, 15), datetime.NewDate(2023, 4, 21)); result != 2 {
+		t.Errorf("Weekend(Fri, Sat).DaysBetween() = %d; want 2", result)
+	}
+}
+
+func TestNextWorkingDay(t *testing.T) {
+	// 2023-04-14 is Friday.
+	holidays := []datetime.Date{datetime.NewDate(2023, 4, 17), datetime.NewDate(2023, 5, 1), datetime.NewDate(2023, 5, 9)}
+	cases := []struct {
+		id       string
+		date     datetime.Date
+		expected string
+	}{
+		{"weekday", datetime.NewDate(2023, 4, 11), "2023-04-12"},
+		{"friday", datetime.NewDate(2023, 4, 7), "2023-04-10"},
+		{"holiday_after_weekend", datetime.NewDate(2023, 4, 14), "2023-04-18"},
+		{"from_weekend", datetime.NewDate(2023, 4, 15), "2023-04-18"},
+		{"from_holiday", datetime.NewDate(2023, 5, 1), "2023-05-02"},
+		{"holiday_midweek", datetime.NewDate(2023, 5, 8), "2023-05-10"},
+	}
+
+	for _, c := range cases {
+		if result := c.date.NextWorkingDay(holidays).String(); result != c.expected {
+			t.Errorf("%s -> NextWorkingDay() = %s; want %s", c.id, result, c.expected)
+		}
+	}
+
+	friSat := datetime.NewWeekend(time.Friday, time.Saturday)
+	if result := friSat.NextWorkingDay(datetime.NewDate(2023, 4, 13), nil).String(); result != "2023-04-16" {
+		t.Errorf("Weekend(Fri, Sat).NextWorkingDay() = %s; want 2023-04-16", result)
+	}
+
+	all := datetime.NewWeekend(time.Sunday, time.Monday, time.Tuesday, time.Wednesday, time.Thursday, time.Friday, time.Saturday)
+	if result := all.NextWorkingDay(datetime.NewDate(2023, 4, 13), nil); !result.IsZero() {
+		t.Errorf("Weekend(all).NextWorkingDay() = %s; want zero date", result)
+	}
+}